@@ -0,0 +1,185 @@
+package stats
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Accumulator summarizes a stream of values in constant memory: count, mean, variance, skewness, kurtosis,
+// minimum and maximum. The moments are updated in one pass with the numerically stable formulas of Welford and
+// Terriberry, and accumulators of separate streams can be combined with Merge.
+// The zero value is an empty accumulator ready to use. MarshalBinary and UnmarshalBinary let the accumulators
+// of separate processes be sent to one another and merged.
+type Accumulator struct {
+	n    int
+	mean float64
+	// m2, m3 and m4 are the sums of the powers of the differences from the mean.
+	m2, m3, m4 float64
+	min, max   float64
+}
+
+// accumulatorEncodingVersion is the first byte of the binary encoding of an Accumulator.
+const accumulatorEncodingVersion = 1
+
+// accumulatorEncodingFloats is the number of float64 of the binary encoding: mean, m2, m3, m4, min and max.
+const accumulatorEncodingFloats = 6
+
+// Add adds the value x to the accumulator. A NaN value makes every statistic NaN.
+func (a *Accumulator) Add(x float64) {
+	if a.n == 0 {
+		a.min, a.max = x, x
+	} else if x < a.min || math.IsNaN(x) {
+		a.min = x
+	}
+	if x > a.max || math.IsNaN(x) {
+		a.max = x
+	}
+
+	n1 := float64(a.n)
+	a.n++
+	n := float64(a.n)
+	delta := x - a.mean
+	dn := delta / n
+	dn2 := dn * dn
+	term := delta * dn * n1
+	a.mean += dn
+	a.m4 += term*dn2*(n*n-3*n+3) + 6*dn2*a.m2 - 4*dn*a.m3
+	a.m3 += term*dn*(n-2) - 3*dn*a.m2
+	a.m2 += term
+}
+
+// AddAll adds every value of input to the accumulator.
+func (a *Accumulator) AddAll(input []float64) {
+	for _, x := range input {
+		a.Add(x)
+	}
+}
+
+// Merge adds the values summarized by b to the accumulator, as if they had been added one by one,
+// with the pairwise formulas of Chan, Golub and LeVeque extended to the higher moments by Pébay.
+func (a *Accumulator) Merge(b Accumulator) {
+	if b.n == 0 {
+		return
+	}
+	if a.n == 0 {
+		*a = b
+		return
+	}
+	na, nb := float64(a.n), float64(b.n)
+	n := na + nb
+	delta := b.mean - a.mean
+	d2 := delta * delta
+
+	m2 := a.m2 + b.m2 + d2*na*nb/n
+	m3 := a.m3 + b.m3 + d2*delta*na*nb*(na-nb)/(n*n) + 3*delta*(na*b.m2-nb*a.m2)/n
+	m4 := a.m4 + b.m4 + d2*d2*na*nb*(na*na-na*nb+nb*nb)/(n*n*n) +
+		6*d2*(na*na*b.m2+nb*nb*a.m2)/(n*n) + 4*delta*(na*b.m3-nb*a.m3)/n
+
+	a.n += b.n
+	a.mean += delta * nb / n
+	a.m2, a.m3, a.m4 = m2, m3, m4
+	if b.min < a.min || math.IsNaN(b.min) {
+		a.min = b.min
+	}
+	if b.max > a.max || math.IsNaN(b.max) {
+		a.max = b.max
+	}
+}
+
+// Count returns the number of values added.
+func (a *Accumulator) Count() int {
+	return a.n
+}
+
+// Mean returns the mean of the values, or NaN if there are none.
+func (a *Accumulator) Mean() float64 {
+	if a.n == 0 {
+		return math.NaN()
+	}
+	return a.mean
+}
+
+// Variance returns the sample variance of the values, as Variance, or NaN if there are less than two.
+func (a *Accumulator) Variance() float64 {
+	if a.n < 2 {
+		return math.NaN()
+	}
+	return a.m2 / float64(a.n-1)
+}
+
+// StdDev returns the sample standard deviation of the values, as StdDev.
+func (a *Accumulator) StdDev() float64 {
+	return math.Sqrt(a.Variance())
+}
+
+// Skewness returns the sample skewness m3/m2^(3/2) of the values, computed from the biased central moments.
+// It is NaN if there are less than two distinct values.
+func (a *Accumulator) Skewness() float64 {
+	if a.n < 2 || a.m2 == 0 {
+		return math.NaN()
+	}
+	n := float64(a.n)
+	return math.Sqrt(n) * a.m3 / math.Pow(a.m2, 1.5)
+}
+
+// Kurtosis returns the sample kurtosis m4/m2² of the values, computed from the biased central moments,
+// which is 3 for a normal distribution. It is NaN if there are less than two distinct values.
+func (a *Accumulator) Kurtosis() float64 {
+	if a.n < 2 || a.m2 == 0 {
+		return math.NaN()
+	}
+	return float64(a.n) * a.m4 / (a.m2 * a.m2)
+}
+
+// Min returns the smallest value, or NaN if there are none.
+func (a *Accumulator) Min() float64 {
+	if a.n == 0 {
+		return math.NaN()
+	}
+	return a.min
+}
+
+// Max returns the largest value, or NaN if there are none.
+func (a *Accumulator) Max() float64 {
+	if a.n == 0 {
+		return math.NaN()
+	}
+	return a.max
+}
+
+// MarshalBinary encodes the accumulator in a binary form, which UnmarshalBinary decodes: the count as a varint
+// followed by the mean, the sums of the powers of the differences from the mean, the minimum and the maximum.
+func (a *Accumulator) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 1+binary.MaxVarintLen64+8*accumulatorEncodingFloats)
+	buf[0] = accumulatorEncodingVersion
+	size := 1 + binary.PutUvarint(buf[1:], uint64(a.n))
+	for _, v := range []float64{a.mean, a.m2, a.m3, a.m4, a.min, a.max} {
+		binary.LittleEndian.PutUint64(buf[size:], math.Float64bits(v))
+		size += 8
+	}
+	return buf[:size], nil
+}
+
+// UnmarshalBinary decodes an accumulator encoded by MarshalBinary into a, replacing its content.
+func (a *Accumulator) UnmarshalBinary(data []byte) error {
+	invalid := errors.New("stats: invalid accumulator encoding")
+	if len(data) < 1 || data[0] != accumulatorEncodingVersion {
+		return invalid
+	}
+	n, read := binary.Uvarint(data[1:])
+	if read <= 0 || n > math.MaxInt64 || len(data) != 1+read+8*accumulatorEncodingFloats {
+		return invalid
+	}
+	var v [accumulatorEncodingFloats]float64
+	for i := range v {
+		v[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[1+read+8*i:]))
+	}
+	d := Accumulator{n: int(n), mean: v[0], m2: v[1], m3: v[2], m4: v[3], min: v[4], max: v[5]}
+	// NaN values make every statistic NaN, which is kept, but the other moments must be consistent.
+	if d.m2 < 0 || d.m4 < 0 || d.min > d.max || (d.n == 0 && d != Accumulator{}) {
+		return invalid
+	}
+	*a = d
+	return nil
+}
@@ -0,0 +1,186 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// checkAccumulator compares the statistics of acc with the ones of the batch functions on input.
+func checkAccumulator(t *testing.T, acc *Accumulator, input []float64) {
+	t.Helper()
+	skewness, kurtosis := shapeMoments(input)
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Mean", acc.Mean(), Mean(input)},
+		{"Variance", acc.Variance(), Variance(input)},
+		{"StdDev", acc.StdDev(), StdDev(input)},
+		{"Skewness", acc.Skewness(), skewness},
+		{"Kurtosis", acc.Kurtosis(), kurtosis},
+		{"Min", acc.Min(), Min(input)},
+		{"Max", acc.Max(), Max(input)},
+	}
+	if acc.Count() != len(input) {
+		t.Errorf("Count() = %v, want %v", acc.Count(), len(input))
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > 1e-9*math.Max(1, math.Abs(tt.want)) {
+			t.Errorf("%v() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	skewed := make([]float64, 1000)
+	for i := range skewed {
+		skewed[i] = rnd.ExpFloat64()
+	}
+	tests := []struct {
+		name  string
+		input []float64
+	}{
+		{"Small case", []float64{2, 4, 4, 4, 5, 5, 7, 9}},
+		{"Skewed case", skewed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var acc Accumulator
+			acc.AddAll(tt.input)
+			checkAccumulator(t, &acc, tt.input)
+		})
+	}
+}
+
+func TestAccumulator_Shifted(t *testing.T) {
+	// A large offset makes naive sums of powers lose every significant digit, while the updates only lose
+	// the precision of the values themselves. The statistics must not depend on the offset.
+	rnd := rand.New(rand.NewSource(3))
+	var acc Accumulator
+	centered := make([]float64, 1000)
+	for i := range centered {
+		x := 1e9 + rnd.NormFloat64()
+		acc.Add(x)
+		// The subtraction is exact, so centered holds the same values without offset.
+		centered[i] = x - 1e9
+	}
+	skewness, kurtosis := shapeMoments(centered)
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Mean", acc.Mean() - 1e9, Mean(centered)},
+		{"Variance", acc.Variance(), Variance(centered)},
+		{"Skewness", acc.Skewness(), skewness},
+		{"Kurtosis", acc.Kurtosis(), kurtosis},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > 1e-6 {
+			t.Errorf("%v() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestAccumulator_Merge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	input := make([]float64, 1000)
+	for i := range input {
+		input[i] = 10 + 3*rnd.ExpFloat64()
+	}
+	tests := []struct {
+		name   string
+		splits []int
+	}{
+		{"Halves case", []int{500}},
+		{"Unbalanced case", []int{1, 7, 900}},
+		{"Empty part case", []int{0, 0, 300, 300}},
+		{"Many parts case", []int{100, 200, 300, 400, 500, 600, 700, 800, 900}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var acc Accumulator
+			start := 0
+			for _, end := range append(tt.splits, len(input)) {
+				var part Accumulator
+				part.AddAll(input[start:end])
+				acc.Merge(part)
+				start = end
+			}
+			checkAccumulator(t, &acc, input)
+		})
+	}
+}
+
+func TestAccumulator_Special(t *testing.T) {
+	var acc Accumulator
+	for name, got := range map[string]float64{"Mean": acc.Mean(), "Variance": acc.Variance(), "Skewness": acc.Skewness(),
+		"Kurtosis": acc.Kurtosis(), "Min": acc.Min(), "Max": acc.Max()} {
+		if !math.IsNaN(got) {
+			t.Errorf("empty %v() = %v, want NaN", name, got)
+		}
+	}
+
+	acc.Add(3)
+	if acc.Mean() != 3 || acc.Min() != 3 || acc.Max() != 3 || !math.IsNaN(acc.Variance()) {
+		t.Errorf("single value accumulator = %+v", acc)
+	}
+	acc.Add(3)
+	if acc.Variance() != 0 || !math.IsNaN(acc.Skewness()) {
+		t.Errorf("constant accumulator Variance() = %v, Skewness() = %v, want 0 and NaN", acc.Variance(), acc.Skewness())
+	}
+
+	acc.Add(math.NaN())
+	acc.Add(1)
+	if !math.IsNaN(acc.Mean()) || !math.IsNaN(acc.Min()) || !math.IsNaN(acc.Max()) {
+		t.Errorf("accumulator with NaN Mean() = %v, Min() = %v, Max() = %v, want NaN", acc.Mean(), acc.Min(), acc.Max())
+	}
+}
+
+func TestAccumulator_Binary(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	input := make([]float64, 1000)
+	for i := range input {
+		input[i] = rnd.NormFloat64()
+	}
+	// Each half is summarized apart, sent as bytes and merged, as by distributed agents.
+	var merged Accumulator
+	for _, part := range [][]float64{input[:400], input[400:]} {
+		var acc Accumulator
+		acc.AddAll(part)
+		data, err := acc.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() error = %v", err)
+		}
+		var decoded Accumulator
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() error = %v", err)
+		}
+		if decoded != acc {
+			t.Errorf("decoded accumulator = %+v, want %+v", decoded, acc)
+		}
+		merged.Merge(decoded)
+	}
+	checkAccumulator(t, &merged, input)
+
+	var empty Accumulator
+	data, _ := empty.MarshalBinary()
+	if err := merged.UnmarshalBinary(data); err != nil || merged.Count() != 0 || !math.IsNaN(merged.Mean()) {
+		t.Errorf("decoded empty accumulator = %+v, %v", merged, err)
+	}
+
+	var acc Accumulator
+	acc.AddAll([]float64{1, 2, 3})
+	data, _ = acc.MarshalBinary()
+	negative := append([]byte(nil), data...)
+	// The last float is the maximum, set below the minimum.
+	copy(negative[len(negative)-8:], []byte{0, 0, 0, 0, 0, 0, 0xf0, 0xbf})
+	for _, bad := range [][]byte{nil, data[:5], append(append([]byte(nil), data...), 0), append([]byte{2}, data[1:]...), negative} {
+		if err := new(Accumulator).UnmarshalBinary(bad); err == nil {
+			t.Errorf("UnmarshalBinary() of %v should fail", bad)
+		}
+	}
+}
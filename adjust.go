@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// AdjustMethod represents a method to adjust p-values for multiple comparisons.
+type AdjustMethod uint8
+
+const (
+	// Bonferroni multiplies each p-value by the number of tests. It controls the family-wise error rate.
+	Bonferroni AdjustMethod = iota
+	// Holm is the step-down version of Bonferroni. It controls the family-wise error rate and is always more powerful.
+	Holm
+	// Hochberg is a step-up method that controls the family-wise error rate when the tests are independent
+	// or positively dependent.
+	Hochberg
+	// BenjaminiHochberg controls the false discovery rate when the tests are independent or positively dependent.
+	BenjaminiHochberg
+	// BenjaminiYekutieli controls the false discovery rate under any dependence between the tests.
+	BenjaminiYekutieli
+)
+
+// AdjustPValues returns the p-values adjusted for multiple comparisons with the given method, in the same order,
+// and for each of them whether its null hypothesis is rejected at the alpha level, i.e. adjusted p-value < alpha.
+// NaN p-values are left out of the number of tests, stay NaN and are never rejected.
+func AdjustPValues(pvalues []float64, alpha float64, method AdjustMethod) ([]float64, []bool) {
+	adjusted := make([]float64, len(pvalues))
+	var idx []int
+	for i, p := range pvalues {
+		adjusted[i] = math.NaN()
+		if !math.IsNaN(p) {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return pvalues[idx[i]] < pvalues[idx[j]] })
+	m := float64(len(idx))
+
+	switch method {
+	case Bonferroni:
+		for _, i := range idx {
+			adjusted[i] = math.Min(1, m*pvalues[i])
+		}
+	case Holm:
+		// Step down from the smallest p-value, keeping the adjusted values non-decreasing.
+		largest := 0.0
+		for r, i := range idx {
+			largest = math.Max(largest, math.Min(1, (m-float64(r))*pvalues[i]))
+			adjusted[i] = largest
+		}
+	case Hochberg, BenjaminiHochberg, BenjaminiYekutieli:
+		q := 1.0
+		if method == BenjaminiYekutieli {
+			q = 0
+			for k := 1.0; k <= m; k++ {
+				q += 1 / k
+			}
+		}
+		// Step up from the largest p-value, keeping the adjusted values non-increasing.
+		smallest := 1.0
+		for r := len(idx) - 1; r >= 0; r-- {
+			i := idx[r]
+			factor := m - float64(r)
+			if method != Hochberg {
+				factor = q * m / float64(r+1)
+			}
+			smallest = math.Min(smallest, factor*pvalues[i])
+			adjusted[i] = smallest
+		}
+	default:
+		panic("stats: incorrect adjust method. Try Bonferroni, Holm, Hochberg, BenjaminiHochberg or BenjaminiYekutieli")
+	}
+
+	rejected := make([]bool, len(pvalues))
+	for i, p := range adjusted {
+		rejected[i] = p < alpha
+	}
+	return adjusted, rejected
+}
+
+// AdjustedTestResult holds a test result adjusted for multiple comparisons by AdjustTestResults.
+// The embedded TestResult keeps the unadjusted p-value, confidence interval and critical values of the test,
+// only Accepted is decided on AdjustedPValue.
+type AdjustedTestResult struct {
+	TestResult
+	// AdjustedPValue is the p-value adjusted for the multiple comparisons.
+	AdjustedPValue float64
+}
+
+// String formats the test result followed by the adjusted p-value, for example
+// "One sample t test: t(4) = 4.24, p = .013, 95% CI [1.04, 4.96], d = 1.90, adjusted p = .026".
+func (r AdjustedTestResult) String() string {
+	return r.TestResult.String() + ", adjusted " + formatPValue(r.AdjustedPValue)
+}
+
+// AdjustTestResults adjusts a batch of test results for multiple comparisons with the given method.
+// It returns copies of the results with their adjusted p-value, whose Accepted is decided on the adjusted p-value,
+// each result keeping its own Alpha. The input results are not modified.
+func AdjustTestResults(results []TestResult, method AdjustMethod) []AdjustedTestResult {
+	pvalues := make([]float64, len(results))
+	for i, r := range results {
+		pvalues[i] = r.PValue
+	}
+	adjusted, _ := AdjustPValues(pvalues, 0, method)
+	out := make([]AdjustedTestResult, len(results))
+	for i, r := range results {
+		r.Accepted = adjusted[i] >= r.Alpha
+		out[i] = AdjustedTestResult{TestResult: r, AdjustedPValue: adjusted[i]}
+	}
+	return out
+}
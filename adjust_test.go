@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdjustPValues(t *testing.T) {
+	nan := math.NaN()
+	pvalues := []float64{0.04, 0.001, 0.03, nan, 0.2, 0.012}
+	by := 1 + 1.0/2 + 1.0/3 + 1.0/4 + 1.0/5
+	tests := []struct {
+		name     string
+		method   AdjustMethod
+		want     []float64
+		rejected []bool
+	}{
+		{"Bonferroni case", Bonferroni, []float64{0.2, 0.005, 0.15, nan, 1, 0.06}, []bool{false, true, false, false, false, false}},
+		{"Holm case", Holm, []float64{0.09, 0.005, 0.09, nan, 0.2, 0.048}, []bool{false, true, false, false, false, true}},
+		{"Hochberg case", Hochberg, []float64{0.08, 0.005, 0.08, nan, 0.2, 0.048}, []bool{false, true, false, false, false, true}},
+		{"Benjamini-Hochberg case", BenjaminiHochberg, []float64{0.05, 0.005, 0.05, nan, 0.2, 0.03}, []bool{false, true, false, false, false, true}},
+		{"Benjamini-Yekutieli case", BenjaminiYekutieli, []float64{0.05 * by, 0.005 * by, 0.05 * by, nan, 0.2 * by, 0.03 * by}, []bool{false, true, false, false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rejected := AdjustPValues(pvalues, 0.05, tt.method)
+			for i := range tt.want {
+				if math.IsNaN(got[i]) || math.IsNaN(tt.want[i]) {
+					if !math.IsNaN(got[i]) || !math.IsNaN(tt.want[i]) {
+						t.Errorf("AdjustPValues() = %v, want %v", got, tt.want)
+					}
+				} else if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("AdjustPValues() = %v, want %v", got, tt.want)
+				}
+				if rejected[i] != tt.rejected[i] {
+					t.Errorf("AdjustPValues() rejected = %v, want %v", rejected, tt.rejected)
+				}
+			}
+		})
+	}
+}
+
+func TestAdjustPValues_Empty(t *testing.T) {
+	got, rejected := AdjustPValues(nil, 0.05, Holm)
+	if len(got) != 0 || len(rejected) != 0 {
+		t.Errorf("AdjustPValues() = %v, %v, want empty slices", got, rejected)
+	}
+}
+
+func TestAdjustTestResults(t *testing.T) {
+	results := []TestResult{
+		OneSampleTTest([]float64{1, 2, 3, 4, 5}, 0, 0.05, TailBoth),
+		OneSampleTTest([]float64{1, 2, 3, 4, 5}, 2, 0.05, TailBoth),
+		OneSampleTTest([]float64{1, 2, 3, 4, 5}, 0, 0.01, TailBoth),
+	}
+	pvalues := []float64{results[0].PValue, results[1].PValue, results[2].PValue}
+	want, _ := AdjustPValues(pvalues, 0.05, Holm)
+	got := AdjustTestResults(results, Holm)
+	for i := range got {
+		if got[i].AdjustedPValue != want[i] {
+			t.Errorf("AdjustTestResults()[%v] AdjustedPValue = %v, want %v", i, got[i].AdjustedPValue, want[i])
+		}
+		if got[i].PValue != pvalues[i] || got[i].ConfidenceInterval != results[i].ConfidenceInterval {
+			t.Errorf("AdjustTestResults()[%v] did not keep the unadjusted p-value and interval", i)
+		}
+		if got[i].Accepted != (want[i] >= results[i].Alpha) {
+			t.Errorf("AdjustTestResults()[%v] Accepted = %v with p = %v and alpha = %v", i, got[i].Accepted, want[i], results[i].Alpha)
+		}
+		if results[i].PValue != pvalues[i] {
+			t.Errorf("AdjustTestResults() modified its input")
+		}
+	}
+	// The first test is rejected at 0.05 but not at 0.01 once adjusted.
+	if got[0].Accepted || !got[2].Accepted {
+		t.Errorf("AdjustTestResults() Accepted = %v, %v, want false, true", got[0].Accepted, got[2].Accepted)
+	}
+	if want := results[1].String() + ", adjusted p = .230"; got[1].String() != want {
+		t.Errorf("AdjustTestResults()[1].String() = %q, want %q", got[1].String(), want)
+	}
+}
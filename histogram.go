@@ -0,0 +1,282 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// Histogram is a high dynamic range histogram in the style of HdrHistogram: it counts integer values, such as
+// latencies in microseconds, from 0 to a highest trackable value, in fixed memory, with a bounded relative error.
+// The buckets are log-linear: each power of two range is split into linear sub-buckets, enough of them to keep
+// the given number of significant decimal digits, so a value v is known to within v / 10^digits.
+// It is created by NewHistogram.
+type Histogram struct {
+	highest int64
+	// A value v is in the bucket of its power of two range, whose sub-buckets are v >> (bucket + unitMagnitude).
+	unitMagnitude               uint
+	subBucketHalfCountMagnitude uint
+	subBucketCount              int64
+	subBucketHalfCount          int64
+	subBucketMask               int64
+	counts                      []int64
+	total                       int64
+	min, max                    int64
+}
+
+// HistogramBucket is a range of equivalent values of a Histogram, from Low to High inclusive,
+// and the number of recorded values in it.
+type HistogramBucket struct {
+	Low, High int64
+	Count     int64
+}
+
+// NewHistogram returns an empty histogram for values from 0 to highest, with the given number of significant
+// digits, from 1 to 5. lowest is the smallest value that must be told apart from 0, at least 1: the values are
+// counted in units of the largest power of two not above lowest, and 2 * 10^digits of these units must fit
+// in an int64. Its memory is in the order of 8 * 10^digits * log2(highest/lowest) bytes.
+func NewHistogram(lowest int64, highest int64, digits int) (*Histogram, error) {
+	if lowest < 1 || highest < 2*lowest || digits < 1 || digits > 5 {
+		return nil, errors.New("stats: invalid histogram parameters. Check lowest >= 1, highest >= 2*lowest and 1 <= digits <= 5")
+	}
+	h := &Histogram{highest: highest, min: math.MaxInt64}
+
+	// The sub-buckets must tell apart every unit up to 2 * 10^digits.
+	largestSingleUnit := 2 * int64(math.Pow10(digits))
+	subBucketCountMagnitude := uint(math.Ceil(math.Log2(float64(largestSingleUnit))))
+	h.subBucketHalfCountMagnitude = subBucketCountMagnitude - 1
+	h.unitMagnitude = uint(bits.Len64(uint64(lowest)) - 1)
+	if h.unitMagnitude+h.subBucketHalfCountMagnitude > 61 {
+		// The first bucket would not fit in an int64.
+		return nil, errors.New("stats: invalid histogram parameters. Check lowest is small enough for the digits")
+	}
+	h.subBucketCount = 1 << subBucketCountMagnitude
+	h.subBucketHalfCount = h.subBucketCount / 2
+	h.subBucketMask = (h.subBucketCount - 1) << h.unitMagnitude
+
+	// Each bucket doubles the range of values, the first one covering subBucketCount units.
+	bucketCount := int64(1)
+	smallestUntrackable := h.subBucketCount << h.unitMagnitude
+	for smallestUntrackable <= highest {
+		if smallestUntrackable > math.MaxInt64/2 {
+			bucketCount++
+			break
+		}
+		smallestUntrackable <<= 1
+		bucketCount++
+	}
+	h.counts = make([]int64, (bucketCount+1)*h.subBucketHalfCount)
+	return h, nil
+}
+
+// Record records the value v, which must be between 0 and the highest trackable value.
+func (h *Histogram) Record(v int64) error {
+	return h.recordN(v, 1)
+}
+
+// RecordCorrectedValue records the value v and corrects for coordinated omission: when v is larger than the
+// expected interval between two measurements, the measurements that a stall of the measured system prevented
+// are recorded too, as values decreasing from v - expectedInterval by steps of expectedInterval.
+// An expectedInterval <= 0 disables the correction.
+func (h *Histogram) RecordCorrectedValue(v int64, expectedInterval int64) error {
+	if err := h.Record(v); err != nil {
+		return err
+	}
+	if expectedInterval <= 0 {
+		return nil
+	}
+	for missing := v - expectedInterval; missing >= expectedInterval; missing -= expectedInterval {
+		if err := h.Record(missing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge adds the values recorded by o to h. The histograms may have different ranges and precisions, but every
+// value recorded by o must be trackable by h, otherwise Merge returns an error and h is not modified.
+func (h *Histogram) Merge(o *Histogram) error {
+	if o.total == 0 {
+		return nil
+	}
+	if o.max > h.highest {
+		return errors.New("stats: the merged histogram has values above the highest trackable value")
+	}
+	hMin, hMax := h.min, h.max
+	for i, n := range o.counts {
+		if n != 0 {
+			if err := h.recordN(o.valueFromIndex(i), n); err != nil {
+				return err
+			}
+		}
+	}
+	// The extremes are kept exact rather than rounded to the buckets of o.
+	h.min, h.max = hMin, hMax
+	if o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+	return nil
+}
+
+// Count returns the number of recorded values.
+func (h *Histogram) Count() int64 {
+	return h.total
+}
+
+// Min returns the smallest recorded value, rounded down to its bucket, or 0 if there are none.
+func (h *Histogram) Min() int64 {
+	if h.total == 0 {
+		return 0
+	}
+	return h.lowestEquivalentValue(h.min)
+}
+
+// Max returns the largest recorded value, rounded up to its bucket, or 0 if there are none.
+func (h *Histogram) Max() int64 {
+	if h.total == 0 {
+		return 0
+	}
+	return h.highestEquivalentValue(h.max)
+}
+
+// ValueAtPercentile returns the recorded value of rank round(percentile/100 * Count()), rounded up to its bucket,
+// as HdrHistogram. percentile is clamped to [0, 100]; 0 gives Min. It returns 0 if there are no values.
+func (h *Histogram) ValueAtPercentile(percentile float64) int64 {
+	if h.total == 0 {
+		return 0
+	}
+	if !(percentile > 0) {
+		return h.Min()
+	}
+	percentile = math.Min(percentile, 100)
+	target := int64(percentile/100*float64(h.total) + 0.5)
+	if target < 1 {
+		target = 1
+	}
+	cumulative := int64(0)
+	for i, n := range h.counts {
+		cumulative += n
+		if cumulative >= target {
+			return h.highestEquivalentValue(h.valueFromIndex(i))
+		}
+	}
+	return h.Max()
+}
+
+// Mean returns the mean of the recorded values, each one being taken at the middle of its bucket,
+// or NaN if there are none.
+func (h *Histogram) Mean() float64 {
+	if h.total == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for i, n := range h.counts {
+		if n != 0 {
+			sum += float64(n) * float64(h.medianEquivalentValue(h.valueFromIndex(i)))
+		}
+	}
+	return sum / float64(h.total)
+}
+
+// StdDev returns the sample standard deviation of the recorded values, as StdDev, each one being taken
+// at the middle of its bucket, or NaN if there are less than two.
+func (h *Histogram) StdDev() float64 {
+	if h.total < 2 {
+		return math.NaN()
+	}
+	mean := h.Mean()
+	ssd := 0.0
+	for i, n := range h.counts {
+		if n != 0 {
+			d := float64(h.medianEquivalentValue(h.valueFromIndex(i))) - mean
+			ssd += float64(n) * d * d
+		}
+	}
+	return math.Sqrt(ssd / float64(h.total-1))
+}
+
+// Buckets returns the non-empty buckets of the histogram in increasing order of values.
+func (h *Histogram) Buckets() []HistogramBucket {
+	var buckets []HistogramBucket
+	for i, n := range h.counts {
+		if n != 0 {
+			v := h.valueFromIndex(i)
+			buckets = append(buckets, HistogramBucket{Low: v, High: h.highestEquivalentValue(v), Count: n})
+		}
+	}
+	return buckets
+}
+
+// recordN records n times the value v.
+func (h *Histogram) recordN(v int64, n int64) error {
+	if v < 0 || v > h.highest {
+		return errors.New("stats: the value is outside of the histogram range")
+	}
+	h.counts[h.countsIndex(v)] += n
+	h.total += n
+	if v < h.min {
+		h.min = v
+	}
+	if v > h.max {
+		h.max = v
+	}
+	return nil
+}
+
+// bucketIndex returns the index of the power of two range of v.
+func (h *Histogram) bucketIndex(v int64) int {
+	pow2Ceiling := bits.Len64(uint64(v | h.subBucketMask))
+	return pow2Ceiling - int(h.unitMagnitude) - int(h.subBucketHalfCountMagnitude+1)
+}
+
+// subBucketIndex returns the index of the sub-bucket of v in its bucket.
+func (h *Histogram) subBucketIndex(v int64, bucket int) int64 {
+	return v >> (uint(bucket) + h.unitMagnitude)
+}
+
+// countsIndex returns the index in counts of the value v. The buckets after the first one only use their upper half
+// of sub-buckets, the lower half being covered by the previous bucket with a finer resolution.
+func (h *Histogram) countsIndex(v int64) int {
+	bucket := h.bucketIndex(v)
+	sub := h.subBucketIndex(v, bucket)
+	return int((int64(bucket+1) << h.subBucketHalfCountMagnitude) + sub - h.subBucketHalfCount)
+}
+
+// valueFromIndex returns the lowest value of the sub-bucket at index i in counts.
+func (h *Histogram) valueFromIndex(i int) int64 {
+	bucket := (i >> h.subBucketHalfCountMagnitude) - 1
+	sub := int64(i)&(h.subBucketHalfCount-1) + h.subBucketHalfCount
+	if bucket < 0 {
+		sub -= h.subBucketHalfCount
+		bucket = 0
+	}
+	return sub << (uint(bucket) + h.unitMagnitude)
+}
+
+// sizeOfEquivalentRange returns the number of values that share the sub-bucket of v.
+func (h *Histogram) sizeOfEquivalentRange(v int64) int64 {
+	bucket := h.bucketIndex(v)
+	if h.subBucketIndex(v, bucket) >= h.subBucketCount {
+		bucket++
+	}
+	return 1 << (h.unitMagnitude + uint(bucket))
+}
+
+// lowestEquivalentValue returns the smallest value that shares the sub-bucket of v.
+func (h *Histogram) lowestEquivalentValue(v int64) int64 {
+	bucket := h.bucketIndex(v)
+	return h.subBucketIndex(v, bucket) << (uint(bucket) + h.unitMagnitude)
+}
+
+// highestEquivalentValue returns the largest value that shares the sub-bucket of v.
+func (h *Histogram) highestEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentRange(v) - 1
+}
+
+// medianEquivalentValue returns the value in the middle of the sub-bucket of v.
+func (h *Histogram) medianEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentRange(v)/2
+}
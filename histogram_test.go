@@ -0,0 +1,181 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHistogram_ValueAtPercentile(t *testing.T) {
+	// The reference values are the ones of HdrHistogram for the same histogram.
+	h, err := NewHistogram(1, 10000000, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(0); i < 1000000; i++ {
+		if err := h.Record(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		percentile float64
+		want       int64
+	}{
+		{50, 500223},
+		{75, 750079},
+		{90, 900095},
+		{95, 950271},
+		{99, 990207},
+		{99.9, 999423},
+		{99.99, 999935},
+		{100, 1000447},
+		{0, 0},
+	}
+	for _, tt := range tests {
+		if got := h.ValueAtPercentile(tt.percentile); got != tt.want {
+			t.Errorf("ValueAtPercentile(%v) = %v, want %v", tt.percentile, got, tt.want)
+		}
+	}
+	if got := h.Count(); got != 1000000 {
+		t.Errorf("Count() = %v, want 1000000", got)
+	}
+	if got := h.Max(); got != 1000447 {
+		t.Errorf("Max() = %v, want 1000447", got)
+	}
+	if got := h.Mean(); math.Abs(got-499999.5) > 499999.5e-3 {
+		t.Errorf("Mean() = %v, want 499999.5", got)
+	}
+	if got, want := h.StdDev(), 1000000/math.Sqrt(12); math.Abs(got-want) > want*1e-3 {
+		t.Errorf("StdDev() = %v, want %v", got, want)
+	}
+}
+
+func TestHistogram_RelativeError(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, digits := range []int{1, 2, 3, 4, 5} {
+		h, err := NewHistogram(1, 1e12, digits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := make([]float64, 10000)
+		for i := range values {
+			v := int64(math.Exp(rnd.Float64() * math.Log(1e12)))
+			values[i] = float64(v)
+			if err := h.Record(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		resolution := math.Pow10(-digits)
+		for _, p := range []float64{1, 50, 99, 99.9, 100} {
+			got := float64(h.ValueAtPercentile(p))
+			want := Quantile(values, p/100, QuantileType1)
+			if got < want || got-want > math.Max(1, want*resolution) {
+				t.Errorf("%d digits ValueAtPercentile(%v) = %v, want %v within %v", digits, p, got, want, resolution)
+			}
+		}
+		var total int64
+		last := int64(-1)
+		for _, b := range h.Buckets() {
+			if b.Low <= last || b.High < b.Low || float64(b.High-b.Low) > float64(b.Low)*resolution {
+				t.Errorf("%d digits bucket %+v after %v is not an ordered range within the resolution", digits, b, last)
+			}
+			last = b.High
+			total += b.Count
+		}
+		if total != h.Count() {
+			t.Errorf("%d digits Buckets() hold %v values, want %v", digits, total, h.Count())
+		}
+	}
+}
+
+func TestHistogram_RecordCorrectedValue(t *testing.T) {
+	h, _ := NewHistogram(1, 100000, 3)
+	if err := h.RecordCorrectedValue(1000, 100); err != nil {
+		t.Fatal(err)
+	}
+	// 1000 hides the 900, 800, ..., 100 measurements that should have been taken during the stall.
+	if got := h.Count(); got != 10 {
+		t.Errorf("Count() = %v, want 10", got)
+	}
+	if got := h.ValueAtPercentile(50); got != 500 {
+		t.Errorf("ValueAtPercentile(50) = %v, want 500", got)
+	}
+	if err := h.RecordCorrectedValue(50, 100); err != nil || h.Count() != 11 {
+		t.Errorf("RecordCorrectedValue() below the interval gave Count() = %v, %v, want 11", h.Count(), err)
+	}
+	if err := h.RecordCorrectedValue(3000, 0); err != nil || h.Count() != 12 {
+		t.Errorf("RecordCorrectedValue() without interval gave Count() = %v, %v, want 12", h.Count(), err)
+	}
+}
+
+func TestHistogram_Merge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	whole, _ := NewHistogram(1, 1e9, 3)
+	a, _ := NewHistogram(1, 1e9, 3)
+	b, _ := NewHistogram(1, 1e6, 2)
+	for i := 0; i < 10000; i++ {
+		v := int64(rnd.ExpFloat64() * 1e4)
+		whole.Record(v)
+		if i%2 == 0 {
+			a.Record(v)
+		} else {
+			b.Record(v)
+		}
+	}
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if a.Count() != whole.Count() || a.Min() != whole.Min() || a.Max() != whole.Max() {
+		t.Errorf("merged Count(), Min(), Max() = %v, %v, %v, want %v, %v, %v",
+			a.Count(), a.Min(), a.Max(), whole.Count(), whole.Min(), whole.Max())
+	}
+	// b has two digits only, so its values are known to 1%.
+	for _, p := range []float64{10, 50, 90, 99, 99.9} {
+		got, want := float64(a.ValueAtPercentile(p)), float64(whole.ValueAtPercentile(p))
+		if math.Abs(got-want) > want*1e-2+1 {
+			t.Errorf("merged ValueAtPercentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+
+	small, _ := NewHistogram(1, 1000, 3)
+	small.Record(10)
+	if err := small.Merge(whole); err == nil {
+		t.Errorf("Merge() of larger values should fail")
+	}
+	if small.Count() != 1 {
+		t.Errorf("failed Merge() modified the histogram: Count() = %v, want 1", small.Count())
+	}
+}
+
+func TestHistogram_Special(t *testing.T) {
+	for _, params := range [][3]int64{{0, 100, 3}, {10, 15, 3}, {1, 100, 0}, {1, 100, 6}, {1 << 55, 1 << 62, 3}, {1 << 45, 1 << 62, 5}} {
+		if _, err := NewHistogram(params[0], params[1], int(params[2])); err == nil {
+			t.Errorf("NewHistogram%v should fail", params)
+		}
+	}
+	// The largest unit accepted for 3 digits still tracks every int64.
+	wide, err := NewHistogram(1<<51, math.MaxInt64, 3)
+	if err != nil || wide.Record(math.MaxInt64) != nil || wide.Record(1<<60) != nil || wide.Count() != 2 {
+		t.Errorf("NewHistogram(1<<51, MaxInt64, 3) cannot record the largest values: %v", err)
+	}
+
+	h, _ := NewHistogram(1, 1000, 2)
+	if h.Count() != 0 || h.Min() != 0 || h.Max() != 0 || h.ValueAtPercentile(50) != 0 || !math.IsNaN(h.Mean()) || !math.IsNaN(h.StdDev()) {
+		t.Errorf("empty histogram statistics are not 0 and NaN")
+	}
+	for _, v := range []int64{-1, 1001} {
+		if err := h.Record(v); err == nil {
+			t.Errorf("Record(%v) should fail", v)
+		}
+	}
+	if h.Count() != 0 || len(h.Buckets()) != 0 {
+		t.Errorf("failed Record() modified the histogram")
+	}
+	// Values are counted in units of 512, the power of two below lowest.
+	coarse, _ := NewHistogram(1000, 1e6, 2)
+	coarse.Record(3)
+	coarse.Record(511)
+	if got := coarse.Buckets(); len(got) != 1 || got[0].Count != 2 {
+		t.Errorf("Buckets() = %+v, want one bucket with two values", got)
+	}
+}
@@ -0,0 +1,251 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// exactKSLimit is the sample size below which KSTest uses the exact distribution of D.
+const exactKSLimit = 100
+
+// KSTest performs the one sample Kolmogorov-Smirnov test of the sample against the fully specified distribution dist.
+// D is the largest distance between the empirical CDF of the sample and dist.CDF. Below 100 values the p-value comes
+// from the exact distribution of D (Marsaglia, Tsang and Wang, 2003), above from the Kolmogorov distribution of sqrt(n) D.
+// The p-value is only valid if the parameters of dist were not estimated from the sample, see Lilliefors for that case,
+// and it is conservative for discrete distributions.
+// Null hypothesis Ho : the sample comes from dist.
+// Alternative hypothesis H1 : the sample does not come from dist.
+func KSTest(sample []float64, dist pd.Distribution, alpha float64) TestResult {
+	if len(sample) == 0 {
+		panic("stats: incorrect samples length")
+	}
+	d := ksStatistic(sample, dist.CDF)
+	n := len(sample)
+	var pvalue float64
+	if n < exactKSLimit {
+		pvalue = 1 - kolmogorovExactCDF(n, d)
+	} else {
+		pvalue = pd.Kolmogorov{}.Survival(math.Sqrt(float64(n)) * d)
+	}
+	return newTestResult("Kolmogorov-Smirnov test", "D", d, math.Max(0, math.Min(1, pvalue)), alpha, TailBoth)
+}
+
+// Lilliefors performs the Lilliefors test of normality: the Kolmogorov-Smirnov test against the normal distribution
+// whose mean and standard deviation are estimated from the sample. The p-value comes from the approximation of
+// Dallal and Wilkinson (1986). The sample needs at least 5 values.
+// Null hypothesis Ho : the sample comes from a normal distribution.
+// Alternative hypothesis H1 : the sample does not come from a normal distribution.
+func Lilliefors(sample []float64, alpha float64) TestResult {
+	n := len(sample)
+	if n < 5 {
+		panic("stats: the sample size must be at least 5")
+	}
+	dist := pd.Normal{Mu: Mean(sample), Sigma: StdDev(sample)}
+	if !(dist.Sigma > 0) {
+		panic("stats: all the values are identical")
+	}
+	d := ksStatistic(sample, dist.CDF)
+
+	nf := float64(n)
+	kd, nd := d, nf
+	if n > 100 {
+		kd, nd = d*math.Pow(nf/100, 0.49), 100
+	}
+	pvalue := math.Exp(-7.01256*kd*kd*(nd+2.78019) + 2.99587*kd*math.Sqrt(nd+2.78019) - 0.122119 + 0.974598/math.Sqrt(nd) + 1.67997/nd)
+	if pvalue > 0.1 {
+		kk := (math.Sqrt(nf) - 0.01 + 0.85/math.Sqrt(nf)) * d
+		switch {
+		case kk <= 0.302:
+			pvalue = 1
+		case kk <= 0.5:
+			pvalue = polynomial([]float64{2.76773, -19.828315, 80.709644, -138.55152, 81.218052}, kk)
+		case kk <= 0.9:
+			pvalue = polynomial([]float64{-4.901232, 40.662806, -97.490286, 94.029866, -32.355711}, kk)
+		case kk <= 1.31:
+			pvalue = polynomial([]float64{6.198765, -19.558097, 23.186922, -12.257919, 2.375334}, kk)
+		default:
+			pvalue = 0
+		}
+	}
+	return newTestResult("Lilliefors test", "D", d, math.Max(0, math.Min(1, pvalue)), alpha, TailBoth)
+}
+
+// KSTwoSample performs the two sample Kolmogorov-Smirnov test. D is the largest distance between the empirical CDFs
+// of a and b, so the test detects any change in the distribution and not only in its location.
+// When n1*n2 < 10000 and there are no ties between the samples the p-value is exact, otherwise it comes from
+// the Kolmogorov distribution of sqrt(n1*n2/(n1+n2)) D.
+// Null hypothesis Ho : a and b come from the same distribution.
+// Alternative hypothesis H1 : a and b come from different distributions.
+func KSTwoSample(a []float64, b []float64, alpha float64) TestResult {
+	if len(a) == 0 || len(b) == 0 {
+		panic("stats: incorrect samples length")
+	}
+	x := sortedCopy(a)
+	y := sortedCopy(b)
+	n1, n2 := float64(len(x)), float64(len(y))
+
+	d := 0.0
+	ties := false
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		// Move past every value equal to the smallest one, so tied values are counted together.
+		v := math.Min(x[i], y[j])
+		if x[i] == y[j] {
+			ties = true
+		}
+		for i < len(x) && x[i] == v {
+			i++
+		}
+		for j < len(y) && y[j] == v {
+			j++
+		}
+		d = math.Max(d, math.Abs(float64(i)/n1-float64(j)/n2))
+	}
+
+	var pvalue float64
+	if !ties && n1*n2 < 10000 {
+		pvalue = 1 - smirnovExactCDF(len(x), len(y), d)
+	} else {
+		pvalue = pd.Kolmogorov{}.Survival(math.Sqrt(n1*n2/(n1+n2)) * d)
+	}
+	return newTestResult("Two sample Kolmogorov-Smirnov test", "D", d, math.Max(0, math.Min(1, pvalue)), alpha, TailBoth)
+}
+
+// ksStatistic returns the largest distance between the empirical CDF of the sample and cdf.
+func ksStatistic(sample []float64, cdf func(float64) float64) float64 {
+	x := sortedCopy(sample)
+	n := float64(len(x))
+	d := 0.0
+	for i, v := range x {
+		f := cdf(v)
+		d = math.Max(d, math.Max(float64(i+1)/n-f, f-float64(i)/n))
+	}
+	return d
+}
+
+// sortedCopy returns a sorted copy of input.
+func sortedCopy(input []float64) []float64 {
+	x := make([]float64, len(input))
+	copy(x, input)
+	sort.Float64s(x)
+	return x
+}
+
+// kolmogorovExactCDF returns P(D < d) for the one sample Kolmogorov-Smirnov statistic of n values,
+// with the matrix algorithm of Marsaglia, Tsang and Wang (2003).
+func kolmogorovExactCDF(n int, d float64) float64 {
+	if d <= 0 {
+		return 0
+	}
+	if d >= 1 {
+		return 1
+	}
+	nf := float64(n)
+	k := int(nf*d) + 1
+	m := 2*k - 1
+	h := float64(k) - nf*d
+	hm := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 >= 0 {
+				hm[i*m+j] = 1
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		hm[i*m] -= math.Pow(h, float64(i+1))
+		hm[(m-1)*m+i] -= math.Pow(h, float64(m-i))
+	}
+	if 2*h-1 > 0 {
+		hm[(m-1)*m] += math.Pow(2*h-1, float64(m))
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			for g := 2; g <= i-j+1; g++ {
+				hm[i*m+j] /= float64(g)
+			}
+		}
+	}
+	q, e := matrixPower(hm, m, n)
+	s := q[(k-1)*m+k-1]
+	for i := 1; i <= n; i++ {
+		s *= float64(i) / nf
+		if s < 1e-140 {
+			s *= 1e140
+			e -= 140
+		}
+	}
+	return s * math.Pow(10, float64(e))
+}
+
+// matrixPower returns the n-th power of the m x m matrix a as v * 10^e, rescaling to avoid overflows.
+func matrixPower(a []float64, m int, n int) ([]float64, int) {
+	if n == 1 {
+		v := make([]float64, len(a))
+		copy(v, a)
+		return v, 0
+	}
+	v, e := matrixPower(a, m, n/2)
+	b := matrixMultiply(v, v, m)
+	e *= 2
+	if n%2 == 1 {
+		b = matrixMultiply(a, b, m)
+	}
+	if b[(m/2)*m+m/2] > 1e140 {
+		for i := range b {
+			b[i] *= 1e-140
+		}
+		e += 140
+	}
+	return b, e
+}
+
+// matrixMultiply returns the product of the m x m matrices a and b.
+func matrixMultiply(a []float64, b []float64, m int) []float64 {
+	c := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			s := 0.0
+			for k := 0; k < m; k++ {
+				s += a[i*m+k] * b[k*m+j]
+			}
+			c[i*m+j] = s
+		}
+	}
+	return c
+}
+
+// smirnovExactCDF returns P(D < d) for the two sample Kolmogorov-Smirnov statistic of samples of sizes n1 and n2
+// without ties, by counting the paths of the merged samples that stay within d.
+func smirnovExactCDF(n1 int, n2 int, d float64) float64 {
+	if n1 > n2 {
+		n1, n2 = n2, n1
+	}
+	m, n := float64(n1), float64(n2)
+	// D takes values that are multiples of 1/(m*n), q is halfway below d to be safe from rounding errors.
+	q := (0.5 + math.Floor(d*m*n-1e-7)) / (m * n)
+	u := make([]float64, n2+1)
+	for j := range u {
+		if float64(j)/n <= q {
+			u[j] = 1
+		}
+	}
+	for i := 1; i <= n1; i++ {
+		w := float64(i) / float64(i+n2)
+		if float64(i)/m > q {
+			u[0] = 0
+		} else {
+			u[0] *= w
+		}
+		for j := 1; j <= n2; j++ {
+			if math.Abs(float64(i)/m-float64(j)/n) > q {
+				u[j] = 0
+			} else {
+				u[j] = w*u[j] + u[j-1]
+			}
+		}
+	}
+	return u[n2]
+}
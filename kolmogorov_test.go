@@ -0,0 +1,129 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+func TestKSTest(t *testing.T) {
+	_, small, normal, skewed := normalityCases()
+	tests := []struct {
+		name     string
+		sample   []float64
+		dist     pd.Distribution
+		d        float64
+		pvalue   float64
+		accepted bool
+	}{
+		{"Exact case", []float64{-math.Log(0.9), -math.Log(0.6), -math.Log(0.3)}, pd.Exponential{Lambda: 1}, 0.3, 0.886222, true},
+		{"Small normal case", small, pd.Normal{Mu: 3.5, Sigma: 1}, 0.074253, 0.999477, true},
+		{"Normal quantiles case", normal, pd.StandardNormal(), 0.001, 1, true},
+		{"Shifted normal case", normal, pd.Normal{Mu: 0.3, Sigma: 1}, 0.120235, 1.05353e-06, false},
+		{"Exponential quantiles case", skewed, pd.Exponential{Lambda: 1}, 0.001, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := KSTest(tt.sample, tt.dist, 0.05)
+			if math.Abs(res.Statistic-tt.d) > 1e-6 {
+				t.Errorf("KSTest() Statistic = %v, want %v", res.Statistic, tt.d)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("KSTest() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if res.Accepted != tt.accepted {
+				t.Errorf("KSTest() Accepted = %v, want %v", res.Accepted, tt.accepted)
+			}
+		})
+	}
+}
+
+func TestLilliefors(t *testing.T) {
+	weights, small, normal, skewed := normalityCases()
+	checkNormalityTest(t, Lilliefors, []normalityCase{
+		{"Weights case", weights, 0.259215, 0.037408, false},
+		{"Small normal case", small, 0.071448, 0.997344, true},
+		{"Normal quantiles case", normal, 0.001071, 1, true},
+		{"Exponential quantiles case", skewed, 0.158060, 0, false},
+	})
+}
+
+func TestKSTwoSample(t *testing.T) {
+	_, _, normal, skewed := normalityCases()
+	tests := []struct {
+		name     string
+		a, b     []float64
+		d        float64
+		pvalue   float64
+		accepted bool
+	}{
+		// The exact p-value was checked by enumerating the 352716 arrangements of the samples.
+		{"Exact case", []float64{0.61, 0.29, 0.06, 0.59, -1.73, -0.74, 0.51, -0.56, 0.39, 1.64},
+			[]float64{2.20, 1.66, 1.38, 0.20, 0.36, 0.00, 0.96, 1.56, 0.44, 1.50, 1.27}, 0.536364, 0.057820, true},
+		{"Ties case", []float64{1, 2, 2, 3, 4}, []float64{2, 3, 3, 5, 6, 7}, 0.5, 0.502914, true},
+		{"Large sample case", normal, skewed, 0.5, 1.03328e-54, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := KSTwoSample(tt.a, tt.b, 0.05)
+			if math.Abs(res.Statistic-tt.d) > 1e-6 {
+				t.Errorf("KSTwoSample() Statistic = %v, want %v", res.Statistic, tt.d)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("KSTwoSample() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if res.Accepted != tt.accepted {
+				t.Errorf("KSTwoSample() Accepted = %v, want %v", res.Accepted, tt.accepted)
+			}
+		})
+	}
+}
+
+func Test_kolmogorovExactCDF(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		d    float64
+		want float64
+	}{
+		{"Zero case", 5, 0, 0},
+		{"One value case", 1, 0.75, 0.5},
+		// Example given by Marsaglia, Tsang and Wang (2003).
+		{"Paper case", 10, 0.274, 0.6284796154565043},
+		{"Certain case", 10, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kolmogorovExactCDF(tt.n, tt.d); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("kolmogorovExactCDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// The exact distribution converges to the Kolmogorov distribution.
+	n := 2000
+	if got, want := kolmogorovExactCDF(n, 1/math.Sqrt(float64(n))), (pd.Kolmogorov{}).CDF(1); math.Abs(got-want) > 1e-2 {
+		t.Errorf("kolmogorovExactCDF() = %v, want about %v", got, want)
+	}
+}
+
+func Test_smirnovExactCDF(t *testing.T) {
+	// The reference values were computed by enumerating all the arrangements of the samples.
+	tests := []struct {
+		name   string
+		n1, n2 int
+		d      float64
+		want   float64
+	}{
+		{"3x4 case", 3, 4, 0.75, 0.771429},
+		{"4x3 case", 4, 3, 0.75, 0.771429},
+		{"5x5 case", 5, 5, 0.6, 0.642857},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := smirnovExactCDF(tt.n1, tt.n2, tt.d); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("smirnovExactCDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
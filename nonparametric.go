@@ -0,0 +1,573 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// exactRankLimit is the sample size below which rank tests use their exact null distribution when there are no ties.
+const exactRankLimit = 50
+
+// MannWhitneyResult holds the output of a Mann-Whitney U test.
+// The embedded TestResult holds U and the rank-biserial correlation r = 2U/(n1*n2) - 1 as effect size.
+type MannWhitneyResult struct {
+	TestResult
+	// Shift is the Hodges-Lehmann estimate of the location shift of a relative to b: the median of all the differences a[i] - b[j].
+	Shift float64
+	// Exact is true if the p-value comes from the exact distribution of U and false if it comes from the normal approximation.
+	Exact bool
+}
+
+// MannWhitneyUTest performs a Mann-Whitney U test, also known as the Wilcoxon rank-sum test, on two independent samples.
+// It does not assume normality, so it suits skewed data. U is the number of pairs (a[i], b[j]) with a[i] > b[j],
+// ties counting for one half, computed from the ranks of the pooled samples with tied values getting their average rank.
+// When both samples have less than 50 values and there are no ties the p-value is exact.
+// Otherwise it comes from the normal approximation with the variance corrected for ties and a continuity correction.
+// Right tail :
+// Null hypothesis Ho :  a is not stochastically greater than b.
+// Alternative hypothesis H1 : a is stochastically greater than b.
+// Two tails :
+// Null hypothesis Ho :  a and b have the same distribution.
+// Alternative hypothesis H1 : a is shifted with respect to b.
+// Left tail :
+// Null hypothesis Ho :  a is not stochastically smaller than b.
+// Alternative hypothesis H1 : a is stochastically smaller than b.
+func MannWhitneyUTest(a []float64, b []float64, alpha float64, tails TailDirection) MannWhitneyResult {
+	if len(a) == 0 || len(b) == 0 {
+		panic("stats: incorrect samples length")
+	}
+	pooled := make([]float64, 0, len(a)+len(b))
+	pooled = append(pooled, a...)
+	pooled = append(pooled, b...)
+	ranks, ties := rank(pooled)
+	r1 := 0.0
+	for _, r := range ranks[:len(a)] {
+		r1 += r
+	}
+	n1, n2 := float64(len(a)), float64(len(b))
+	u := r1 - n1*(n1+1)/2
+
+	exact := ties == 0 && len(a) < exactRankLimit && len(b) < exactRankLimit
+	var pvalue float64
+	if exact {
+		pvalue = exactPValue(mannWhitneyDistribution(len(a), len(b)), u, tails)
+	} else {
+		n := n1 + n2
+		sigma := math.Sqrt(n1 * n2 / 12 * (n + 1 - ties/(n*(n-1))))
+		pvalue = continuityCorrectedPValue(u-n1*n2/2, sigma, tails)
+	}
+
+	// The differences a[i] - b[j] are not built: with a sorted up and b sorted down they form a sorted matrix.
+	x := sortedCopy(a)
+	y := sortedCopy(b)
+	diffs := sortedMatrix{
+		rows:  len(x),
+		cols:  len(y),
+		first: func(i int) int { return 0 },
+		value: func(i int, j int) float64 { return x[i] - y[len(y)-1-j] },
+	}
+
+	res := newTestResult("Mann-Whitney U test", "U", u, pvalue, alpha, tails)
+	res.EffectSizeName = "r"
+	res.EffectSize = 2*u/(n1*n2) - 1
+	return MannWhitneyResult{TestResult: res, Shift: diffs.median(), Exact: exact}
+}
+
+// mannWhitneyDistribution returns the probabilities of U = 0, 1, ..., n1*n2 under the null hypothesis when there are no ties.
+func mannWhitneyDistribution(n1 int, n2 int) []float64 {
+	// f[j] holds the distribution for i values in the first sample and j in the second, following
+	// f(i, j)(u) = i/(i+j) f(i-1, j)(u-j) + j/(i+j) f(i, j-1)(u), with f(0, j) = f(i, 0) = 1 at u = 0.
+	f := make([][]float64, n2+1)
+	for j := range f {
+		f[j] = []float64{1}
+	}
+	for i := 1; i <= n1; i++ {
+		for j := 1; j <= n2; j++ {
+			next := make([]float64, i*j+1)
+			wi := float64(i) / float64(i+j)
+			wj := float64(j) / float64(i+j)
+			for u, p := range f[j] {
+				next[u+j] += wi * p
+			}
+			for u, p := range f[j-1] {
+				next[u] += wj * p
+			}
+			f[j] = next
+		}
+	}
+	return f[n2]
+}
+
+// ZeroMethod selects how WilcoxonSignedRank handles the differences that are exactly zero.
+type ZeroMethod uint8
+
+const (
+	// ZeroWilcox discards the zero differences before ranking (Wilcoxon's convention).
+	ZeroWilcox ZeroMethod = iota
+	// ZeroPratt ranks the zero differences with the others and then discards their ranks (Pratt's convention).
+	ZeroPratt
+)
+
+// WilcoxonResult holds the output of a Wilcoxon signed-rank test.
+// The embedded TestResult holds the sum of the positive ranks V, the confidence interval of the pseudo-median
+// and the matched pairs rank-biserial correlation r = (2V - S)/S as effect size, where S is the sum of all the ranks.
+type WilcoxonResult struct {
+	TestResult
+	// PseudoMedian is the Hodges-Lehmann estimate of the pseudo-median of the differences: the median of the Walsh averages.
+	PseudoMedian float64
+	// Exact is true if the p-value comes from the exact distribution of V and false if it comes from the normal approximation.
+	Exact bool
+}
+
+// WilcoxonSignedRank performs a Wilcoxon signed-rank test on the differences presample - postsample.
+// It is a nonparametric alternative to PairedTTest that does not assume normal differences and is robust to outliers.
+// The absolute differences are ranked, tied values getting their average rank, and V is the sum of the ranks of the
+// positive differences. zeros selects the convention used for the differences that are exactly zero.
+// When there are less than 50 differences, no ties and no ranked zero the p-value is exact.
+// Otherwise it comes from the normal approximation with the variance computed from the actual ranks and a continuity correction.
+// The confidence interval of the pseudo-median is built from the Walsh averages (d[i] + d[j])/2, i <= j, of the differences
+// left by the zero convention, with the critical values of the exact distribution of V below 50 differences
+// and of its normal approximation above.
+// Right tail :
+// Null hypothesis Ho :  the differences are not shifted above zero.
+// Alternative hypothesis H1 : the differences are shifted above zero.
+// Two tails :
+// Null hypothesis Ho :  the differences are symmetric about zero.
+// Alternative hypothesis H1 : the differences are shifted from zero.
+// Left tail :
+// Null hypothesis Ho :  the differences are not shifted below zero.
+// Alternative hypothesis H1 : the differences are shifted below zero.
+func WilcoxonSignedRank(presample []float64, postsample []float64, zeros ZeroMethod, alpha float64, tails TailDirection) WilcoxonResult {
+	if len(presample) != len(postsample) {
+		panic("stats: incorrect samples length")
+	}
+	var diffs []float64
+	hasZero := false
+	for i, e := range presample {
+		d := e - postsample[i]
+		if d == 0 {
+			hasZero = true
+			if zeros == ZeroWilcox {
+				continue
+			}
+		}
+		diffs = append(diffs, d)
+	}
+	abs := make([]float64, len(diffs))
+	for i, d := range diffs {
+		abs[i] = math.Abs(d)
+	}
+	ranks, ties := rank(abs)
+	// Under the null hypothesis each nonzero rank is added to V with probability 1/2,
+	// so V has mean S/2 and variance sum(r^2)/4, whatever the ties.
+	var v, s, s2 float64
+	for i, d := range diffs {
+		if d == 0 {
+			continue
+		}
+		s += ranks[i]
+		s2 += ranks[i] * ranks[i]
+		if d > 0 {
+			v += ranks[i]
+		}
+	}
+	if s == 0 {
+		panic("stats: all the differences are zero")
+	}
+
+	n := len(diffs)
+	// Zeros ranked by Pratt's convention shift the other ranks away from the exact distribution.
+	exact := ties == 0 && n < exactRankLimit && !(hasZero && zeros == ZeroPratt)
+	var pvalue float64
+	if exact {
+		pvalue = exactPValue(signedRankDistribution(n), v, tails)
+	} else {
+		pvalue = continuityCorrectedPValue(v-s/2, math.Sqrt(s2)/2, tails)
+	}
+
+	// The Walsh averages are not built: with sorted differences they form the upper triangle of a sorted matrix.
+	sorted := sortedCopy(diffs)
+	walsh := sortedMatrix{
+		rows:  n,
+		cols:  n,
+		first: func(i int) int { return i },
+		value: func(i int, j int) float64 { return (sorted[i] + sorted[j]) / 2 },
+	}
+
+	res := newTestResult("Wilcoxon signed-rank test", "V", v, pvalue, alpha, tails)
+	res.ConfidenceInterval = walshInterval(walsh, n, alpha, tails)
+	res.EffectSizeName = "r"
+	res.EffectSize = (2*v - s) / s
+	return WilcoxonResult{TestResult: res, PseudoMedian: walsh.median(), Exact: exact}
+}
+
+// signedRankDistribution returns the probabilities of V = 0, 1, ..., n(n+1)/2 under the null hypothesis
+// when the n ranks are 1, ..., n.
+func signedRankDistribution(n int) []float64 {
+	f := []float64{1}
+	for k := 1; k <= n; k++ {
+		// The rank k is added to V with probability 1/2.
+		next := make([]float64, len(f)+k)
+		for v, p := range f {
+			next[v] += p / 2
+			next[v+k] += p / 2
+		}
+		f = next
+	}
+	return f
+}
+
+// walshInterval returns the 1-alpha confidence interval of the pseudo-median from the Walsh averages of n differences.
+// The bounds are the Walsh averages at the ranks given by the critical values of V.
+func walshInterval(walsh sortedMatrix, n int, alpha float64, tails TailDirection) pd.Interval {
+	a := alpha
+	if tails == TailBoth {
+		a = alpha / 2
+	}
+	m := walsh.size()
+	var k int
+	if n < exactRankLimit {
+		// k is the smallest value such that P(V <= k) >= a.
+		c := 0.0
+		for _, p := range signedRankDistribution(n) {
+			c += p
+			if c >= a {
+				break
+			}
+			k++
+		}
+	} else {
+		nf := float64(n)
+		sigma := math.Sqrt(nf * (nf + 1) * (2*nf + 1) / 24)
+		k = int(math.Floor(float64(m)/2 - pd.StandardNormal().Quantile(1-a)*sigma))
+	}
+	// The interval cannot be wider than the range of the Walsh averages.
+	if k < 1 {
+		k = 1
+	}
+	switch tails {
+	case TailRight:
+		return pd.Interval{Min: walsh.selectK(k - 1), Max: math.Inf(1)}
+	case TailLeft:
+		return pd.Interval{Min: math.Inf(-1), Max: walsh.selectK(m - k)}
+	case TailBoth:
+		return pd.Interval{Min: walsh.selectK(k - 1), Max: walsh.selectK(m - k)}
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
+
+// sortedMatrix is a matrix of rows x cols values that never decrease along a row or a column, of which only
+// the columns from first(i) are kept in row i. It stands for the pairwise differences or averages of the
+// Hodges-Lehmann estimators, whose order statistics are found without building the quadratic number of values.
+type sortedMatrix struct {
+	rows, cols int
+	first      func(i int) int
+	value      func(i int, j int) float64
+}
+
+// size returns the number of values kept in the matrix.
+func (m sortedMatrix) size() int {
+	size := 0
+	for i := 0; i < m.rows; i++ {
+		size += m.cols - m.first(i)
+	}
+	return size
+}
+
+// median returns the median of the values kept in the matrix.
+func (m sortedMatrix) median() float64 {
+	size := m.size()
+	if size%2 == 1 {
+		return m.selectK(size / 2)
+	}
+	return (m.selectK(size/2-1) + m.selectK(size/2)) / 2
+}
+
+// selectK returns the k-th smallest value (0-based) kept in the matrix, with the randomized selection of
+// Monahan (1984): each row keeps a range of candidates, a random candidate is taken as pivot and the ranges are
+// cut by counting the values below it, in expected O((rows + cols) log(rows * cols)) time and O(rows) memory.
+// The random source is seeded, so the result is deterministic.
+func (m sortedMatrix) selectK(k int) float64 {
+	lo := make([]int, m.rows)
+	hi := make([]int, m.rows)
+	for i := range lo {
+		lo[i], hi[i] = m.first(i), m.cols
+	}
+	below := make([]int, m.rows)
+	upTo := make([]int, m.rows)
+	rnd := rand.New(rand.NewSource(1))
+	for {
+		candidates := 0
+		for i := range lo {
+			candidates += hi[i] - lo[i]
+		}
+		if candidates == 0 {
+			panic("stats: order statistic out of the matrix")
+		}
+		r := rnd.Intn(candidates)
+		i := 0
+		for r >= hi[i]-lo[i] {
+			r -= hi[i] - lo[i]
+			i++
+		}
+		pivot := m.value(i, lo[i]+r)
+
+		countBelow := m.count(pivot, false, below)
+		countUpTo := m.count(pivot, true, upTo)
+		switch {
+		case k < countBelow:
+			// The value is below the pivot.
+			for i := range hi {
+				if below[i] < hi[i] {
+					hi[i] = below[i]
+				}
+				if hi[i] < lo[i] {
+					hi[i] = lo[i]
+				}
+			}
+		case k >= countUpTo:
+			// The value is above the pivot.
+			for i := range lo {
+				if upTo[i] > lo[i] {
+					lo[i] = upTo[i]
+				}
+				if lo[i] > hi[i] {
+					lo[i] = hi[i]
+				}
+			}
+		default:
+			return pivot
+		}
+	}
+}
+
+// count sets bound[i] to the column where the values of row i stop being below t, or up to t if orEqual,
+// and returns the number of values kept in the matrix before these bounds. Since the bounds never increase
+// from one row to the next, they are found by walking down the staircase they form.
+func (m sortedMatrix) count(t float64, orEqual bool, bound []int) int {
+	total := 0
+	j := m.cols
+	for i := 0; i < m.rows; i++ {
+		for j > 0 && (m.value(i, j-1) > t || (!orEqual && m.value(i, j-1) == t)) {
+			j--
+		}
+		bound[i] = j
+		if first := m.first(i); bound[i] < first {
+			bound[i] = first
+		}
+		total += bound[i] - m.first(i)
+	}
+	return total
+}
+
+// KruskalWallis performs the Kruskal-Wallis H test on two or more independent groups, a rank based alternative to OneWayANOVA.
+// The pooled values are ranked, tied values getting their average rank, and H is corrected for ties.
+// The p-value comes from the chi-squared distribution with groups - 1 degrees of freedom.
+// The effect size is epsilon squared, H/(n-1).
+// Null hypothesis Ho : all the groups come from the same distribution.
+// Alternative hypothesis H1 : at least one group is stochastically different from another.
+func KruskalWallis(alpha float64, groups ...[]float64) TestResult {
+	sizes, ranks, ties := rankGroups(groups)
+	n := float64(len(ranks))
+	h := 0.0
+	start := 0
+	for _, size := range sizes {
+		r := 0.0
+		for _, x := range ranks[start : start+size] {
+			r += x
+		}
+		h += r * r / float64(size)
+		start += size
+	}
+	h = (12/(n*(n+1))*h - 3*(n+1)) / (1 - ties/(n*n*n-n))
+	dist := pd.ChiSquared{K: float64(len(groups) - 1)}
+	res := newTestResult("Kruskal-Wallis test", "H", h, dist.Survival(h), alpha, TailRight)
+	res.DF = dist.K
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	res.EffectSizeName = "ε²"
+	res.EffectSize = h / (n - 1)
+	return res
+}
+
+// Friedman performs the Friedman test on a blocked design, a rank based alternative to the repeated measures ANOVA.
+// blocks[i][j] is the measure of treatment j in block i, e.g. the benchmark result of Go version j on host i.
+// The values are ranked within each block, tied values getting their average rank, and the statistic is corrected for ties.
+// The p-value comes from the chi-squared distribution with treatments - 1 degrees of freedom.
+// The effect size is Kendall's W, the statistic divided by blocks * (treatments - 1).
+// Null hypothesis Ho : the treatments have the same effect.
+// Alternative hypothesis H1 : at least one treatment has a different effect.
+func Friedman(alpha float64, blocks [][]float64) TestResult {
+	if len(blocks) < 2 || len(blocks[0]) < 2 {
+		panic("stats: at least two blocks and two treatments are needed")
+	}
+	k := len(blocks[0])
+	sums := make([]float64, k)
+	ties := 0.0
+	for _, block := range blocks {
+		if len(block) != k {
+			panic("stats: the blocks must have the same length")
+		}
+		ranks, t := rank(block)
+		for j, r := range ranks {
+			sums[j] += r
+		}
+		ties += t
+	}
+	n, kf := float64(len(blocks)), float64(k)
+	ss := 0.0
+	for _, r := range sums {
+		ss += (r - n*(kf+1)/2) * (r - n*(kf+1)/2)
+	}
+	q := 12 * ss / (n*kf*(kf+1) - ties/(kf-1))
+	dist := pd.ChiSquared{K: kf - 1}
+	res := newTestResult("Friedman test", "χ²", q, dist.Survival(q), alpha, TailRight)
+	res.DF = dist.K
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	res.EffectSizeName = "W"
+	res.EffectSize = q / (n * (kf - 1))
+	return res
+}
+
+// DunnComparison holds Dunn's test between the groups I and J.
+// The embedded TestResult holds the z score of the mean rank difference of I minus J and its unadjusted two tailed p-value.
+// Accepted is decided on AdjustedPValue.
+type DunnComparison struct {
+	TestResult
+	// I and J are the indexes of the compared groups, with I < J.
+	I int
+	J int
+	// AdjustedPValue is the p-value adjusted for the multiple comparisons.
+	AdjustedPValue float64
+}
+
+// DunnTest performs Dunn's post-hoc test after a significant KruskalWallis, comparing every pair of groups.
+// It uses the ranks of the pooled groups, with the variance corrected for ties, and returns the comparisons in the
+// order (0, 1), (0, 2), ..., (1, 2), ... . The p-values are adjusted with the given method, for example Holm to control
+// the family-wise error rate or BenjaminiHochberg to control the false discovery rate of many groups.
+// Null hypothesis Ho : the groups I and J come from the same distribution.
+// Alternative hypothesis H1 : the groups I and J are stochastically different.
+func DunnTest(alpha float64, method AdjustMethod, groups ...[]float64) []DunnComparison {
+	sizes, ranks, ties := rankGroups(groups)
+	n := float64(len(ranks))
+	means := make([]float64, len(groups))
+	start := 0
+	for i, size := range sizes {
+		for _, x := range ranks[start : start+size] {
+			means[i] += x
+		}
+		means[i] /= float64(size)
+		start += size
+	}
+	variance := n*(n+1)/12 - ties/(12*(n-1))
+	std := pd.StandardNormal()
+	var comparisons []DunnComparison
+	var pvalues []float64
+	for i := range groups {
+		for j := i + 1; j < len(groups); j++ {
+			z := (means[i] - means[j]) / math.Sqrt(variance*(1/float64(sizes[i])+1/float64(sizes[j])))
+			p := math.Min(1, 2*std.Survival(math.Abs(z)))
+			res := newTestResult("Dunn test", "z", z, p, alpha, TailBoth)
+			res.CriticalValues = criticalValues(std, alpha, TailBoth)
+			comparisons = append(comparisons, DunnComparison{TestResult: res, I: i, J: j})
+			pvalues = append(pvalues, p)
+		}
+	}
+	adjusted, _ := AdjustPValues(pvalues, alpha, method)
+	for i, p := range adjusted {
+		comparisons[i].AdjustedPValue = p
+		comparisons[i].Accepted = p >= alpha
+	}
+	return comparisons
+}
+
+// rankGroups ranks the pooled values of two or more groups.
+// It returns the size of each group, the ranks in the order of the groups and the tie correction term of rank.
+func rankGroups(groups [][]float64) ([]int, []float64, float64) {
+	if len(groups) < 2 {
+		panic("stats: at least two groups are needed")
+	}
+	sizes := make([]int, len(groups))
+	var pooled []float64
+	for i, g := range groups {
+		if len(g) == 0 {
+			panic("stats: incorrect samples length")
+		}
+		sizes[i] = len(g)
+		pooled = append(pooled, g...)
+	}
+	ranks, ties := rank(pooled)
+	return sizes, ranks, ties
+}
+
+// exactPValue returns the p-value of the integer statistic stat given the probabilities of its values 0, 1, ..., len(dist)-1.
+// The two tailed p-value is twice the one of the smaller tail.
+func exactPValue(dist []float64, stat float64, tails TailDirection) float64 {
+	k := int(stat)
+	left, right := 0.0, 0.0
+	for i, p := range dist {
+		if i <= k {
+			left += p
+		}
+		if i >= k {
+			right += p
+		}
+	}
+	switch tails {
+	case TailRight:
+		return math.Min(1, right)
+	case TailLeft:
+		return math.Min(1, left)
+	case TailBoth:
+		return math.Min(1, 2*math.Min(left, right))
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
+
+// continuityCorrectedPValue returns the normal approximation of the p-value of a discrete statistic
+// that deviates by delta from its null mean, with standard deviation sigma.
+// The deviation is moved 0.5 towards zero, so the two tailed p-value is 1 when |delta| <= 0.5.
+func continuityCorrectedPValue(delta float64, sigma float64, tails TailDirection) float64 {
+	std := pd.StandardNormal()
+	switch tails {
+	case TailRight:
+		return std.Survival((delta - 0.5) / sigma)
+	case TailLeft:
+		return std.CDF((delta + 0.5) / sigma)
+	case TailBoth:
+		return math.Min(1, 2*std.Survival(math.Max(math.Abs(delta)-0.5, 0)/sigma))
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
+
+// rank returns the ranks of values, starting at 1, where tied values get the average of their ranks.
+// It also returns the tie correction term, the sum of t^3 - t over the groups of t tied values.
+func rank(values []float64) ([]float64, float64) {
+	idx := make([]int, len(values))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return values[idx[i]] < values[idx[j]] })
+	ranks := make([]float64, len(values))
+	ties := 0.0
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && values[idx[j]] == values[idx[i]] {
+			j++
+		}
+		// The positions i to j-1 share the ranks i+1 to j.
+		r := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			ranks[idx[k]] = r
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+	return ranks, ties
+}
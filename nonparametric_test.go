@@ -0,0 +1,344 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+func TestMannWhitneyUTest(t *testing.T) {
+	var largeA, largeB []float64
+	for i := 0; i < 60; i++ {
+		largeA = append(largeA, 0.5*float64(i))
+	}
+	for i := 0; i < 55; i++ {
+		largeB = append(largeB, 0.5*float64(i)+7.25)
+	}
+	small := [][]float64{{0.80, 0.83, 1.89, 1.04, 1.45, 1.38, 1.91, 1.64, 0.73, 1.46}, {1.15, 0.88, 0.90, 0.74, 1.21}}
+	tied := [][]float64{{1, 2, 2, 3, 3, 3, 4, 5, 5, 6}, {3, 4, 4, 5, 6, 6, 7, 7, 8}}
+	tests := []struct {
+		name     string
+		a, b     []float64
+		tails    TailDirection
+		u        float64
+		pvalue   float64
+		r        float64
+		shift    float64
+		exact    bool
+		accepted bool
+	}{
+		{"Exact right tail case", small[0], small[1], TailRight, 35, 0.127206, 0.4, 0.305, true, true},
+		{"Exact left tail case", small[0], small[1], TailLeft, 35, 0.896770, 0.4, 0.305, true, true},
+		{"Exact two tails case", small[0], small[1], TailBoth, 35, 0.254412, 0.4, 0.305, true, true},
+		{"Ties right tail case", tied[0], tied[1], TailRight, 15.5, 0.993351, -0.655556, -2, false, true},
+		{"Ties left tail case", tied[0], tied[1], TailLeft, 15.5, 0.008352, -0.655556, -2, false, false},
+		{"Ties two tails case", tied[0], tied[1], TailBoth, 15.5, 0.016704, -0.655556, -2, false, false},
+		{"Large sample case", largeA, largeB, TailBoth, 1035, 0.000581, -0.372727, -6, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := MannWhitneyUTest(tt.a, tt.b, 0.05, tt.tails)
+			if res.Statistic != tt.u {
+				t.Errorf("MannWhitneyUTest() Statistic = %v, want %v", res.Statistic, tt.u)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("MannWhitneyUTest() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if math.Abs(res.EffectSize-tt.r) > 1e-6 {
+				t.Errorf("MannWhitneyUTest() EffectSize = %v, want %v", res.EffectSize, tt.r)
+			}
+			if math.Abs(res.Shift-tt.shift) > 1e-12 {
+				t.Errorf("MannWhitneyUTest() Shift = %v, want %v", res.Shift, tt.shift)
+			}
+			if res.Exact != tt.exact || res.Accepted != tt.accepted {
+				t.Errorf("MannWhitneyUTest() Exact = %v, Accepted = %v, want %v, %v", res.Exact, res.Accepted, tt.exact, tt.accepted)
+			}
+		})
+	}
+}
+
+func TestWilcoxonSignedRank(t *testing.T) {
+	var largePre, largePost []float64
+	for i := 0; i < 60; i++ {
+		largePre = append(largePre, float64(i))
+		largePost = append(largePost, float64(i)-(float64(i%7)-2.2)-0.013*float64(i))
+	}
+	pre := []float64{1.83, 0.50, 1.62, 2.48, 1.68, 1.88, 1.55, 3.06, 1.30}
+	post := []float64{0.878, 0.647, 0.598, 2.05, 1.06, 1.29, 1.06, 3.14, 1.29}
+	tiedPre := []float64{5, 7, 7, 9, 4, 6, 8, 3, 6, 10}
+	tiedPost := []float64{5, 5, 6, 7, 4, 7, 5, 3, 3, 6}
+	tests := []struct {
+		name         string
+		pre, post    []float64
+		zeros        ZeroMethod
+		tails        TailDirection
+		v            float64
+		pvalue       float64
+		r            float64
+		pseudoMedian float64
+		ci           pd.Interval
+		exact        bool
+	}{
+		{"Exact right tail case", pre, post, ZeroWilcox, TailRight, 40, 0.019531, 0.777778, 0.46, pd.Interval{Min: 0.175, Max: math.Inf(1)}, true},
+		{"Exact left tail case", pre, post, ZeroWilcox, TailLeft, 40, 0.986328, 0.777778, 0.46, pd.Interval{Min: math.Inf(-1), Max: 0.726}, true},
+		{"Exact two tails case", pre, post, ZeroWilcox, TailBoth, 40, 0.039063, 0.777778, 0.46, pd.Interval{Min: 0.01, Max: 0.786}, true},
+		{"Wilcox zeros case", tiedPre, tiedPost, ZeroWilcox, TailBoth, 26.5, 0.041418, 0.892857, 2, pd.Interval{}, false},
+		{"Pratt zeros case", tiedPre, tiedPost, ZeroPratt, TailBoth, 44.5, 0.042470, 0.816327, 1.5, pd.Interval{}, false},
+		{"Pratt zeros right tail case", tiedPre, tiedPost, ZeroPratt, TailRight, 44.5, 0.021235, 0.816327, 1.5, pd.Interval{}, false},
+		{"Large sample case", largePre, largePost, ZeroWilcox, TailBoth, 1396, 0.000404, 0.525683, 1.112, pd.Interval{Min: 0.5145, Max: 1.664}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := WilcoxonSignedRank(tt.pre, tt.post, tt.zeros, 0.05, tt.tails)
+			if res.Statistic != tt.v {
+				t.Errorf("WilcoxonSignedRank() Statistic = %v, want %v", res.Statistic, tt.v)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("WilcoxonSignedRank() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if math.Abs(res.EffectSize-tt.r) > 1e-6 {
+				t.Errorf("WilcoxonSignedRank() EffectSize = %v, want %v", res.EffectSize, tt.r)
+			}
+			if math.Abs(res.PseudoMedian-tt.pseudoMedian) > 1e-9 {
+				t.Errorf("WilcoxonSignedRank() PseudoMedian = %v, want %v", res.PseudoMedian, tt.pseudoMedian)
+			}
+			if tt.ci != (pd.Interval{}) && !closeInterval(res.ConfidenceInterval, tt.ci, 1e-9) {
+				t.Errorf("WilcoxonSignedRank() ConfidenceInterval = %v, want %v", res.ConfidenceInterval, tt.ci)
+			}
+			if res.Exact != tt.exact {
+				t.Errorf("WilcoxonSignedRank() Exact = %v, want %v", res.Exact, tt.exact)
+			}
+		})
+	}
+}
+
+func TestKruskalWallis(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   [][]float64
+		h        float64
+		pvalue   float64
+		epsilon2 float64
+		accepted bool
+	}{
+		{"No ties case", [][]float64{{2.9, 3.0, 2.5, 2.6, 3.2}, {3.8, 2.7, 4.0, 2.4}, {2.8, 3.4, 3.7, 2.2, 2.0}}, 0.771429, 0.679965, 0.059341, true},
+		{"Ties case", [][]float64{{1, 2, 2, 3, 4}, {3, 4, 4, 5, 6, 6}, {6, 7, 7, 8, 9, 9, 10}}, 13.865316, 0.000975, 0.815607, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := KruskalWallis(0.05, tt.groups...)
+			if math.Abs(res.Statistic-tt.h) > 1e-6 || res.DF != float64(len(tt.groups)-1) {
+				t.Errorf("KruskalWallis() Statistic = %v, DF = %v, want %v, %v", res.Statistic, res.DF, tt.h, len(tt.groups)-1)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("KruskalWallis() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if math.Abs(res.EffectSize-tt.epsilon2) > 1e-6 {
+				t.Errorf("KruskalWallis() EffectSize = %v, want %v", res.EffectSize, tt.epsilon2)
+			}
+			if res.Accepted != tt.accepted {
+				t.Errorf("KruskalWallis() Accepted = %v, want %v", res.Accepted, tt.accepted)
+			}
+		})
+	}
+}
+
+func TestFriedman(t *testing.T) {
+	blocks := [][]float64{
+		{5.40, 5.50, 5.55}, {5.85, 5.70, 5.75}, {5.20, 5.60, 5.50}, {5.55, 5.50, 5.40}, {5.90, 5.85, 5.70},
+		{5.45, 5.55, 5.60}, {5.40, 5.40, 5.35}, {5.45, 5.50, 5.35}, {5.25, 5.15, 5.00}, {5.85, 5.80, 5.70},
+	}
+	res := Friedman(0.05, blocks)
+	if math.Abs(res.Statistic-2.512821) > 1e-6 || res.DF != 2 {
+		t.Errorf("Friedman() Statistic = %v, DF = %v, want 2.512821, 2", res.Statistic, res.DF)
+	}
+	if math.Abs(res.PValue-0.284674) > 1e-6 || !res.Accepted {
+		t.Errorf("Friedman() PValue = %v, Accepted = %v, want 0.284674, true", res.PValue, res.Accepted)
+	}
+	if math.Abs(res.EffectSize-0.125641) > 1e-6 || res.EffectSizeName != "W" {
+		t.Errorf("Friedman() EffectSize = %v %v, want W 0.125641", res.EffectSizeName, res.EffectSize)
+	}
+}
+
+func TestDunnTest(t *testing.T) {
+	groups := [][]float64{{1, 2, 2, 3, 4}, {3, 4, 4, 5, 6, 6}, {6, 7, 7, 8, 9, 9, 10}}
+	tests := []struct {
+		i, j     int
+		z        float64
+		pvalue   float64
+		adjusted float64
+		accepted bool
+	}{
+		{0, 1, -1.478568, 0.139256, 0.139256, true},
+		{0, 2, -3.655914, 0.000256, 0.000769, false},
+		{1, 2, -2.238464, 0.025191, 0.050382, true},
+	}
+	got := DunnTest(0.05, Holm, groups...)
+	if len(got) != len(tests) {
+		t.Fatalf("DunnTest() returned %v comparisons, want %v", len(got), len(tests))
+	}
+	for k, tt := range tests {
+		res := got[k]
+		if res.I != tt.i || res.J != tt.j {
+			t.Errorf("DunnTest()[%v] compares %v and %v, want %v and %v", k, res.I, res.J, tt.i, tt.j)
+		}
+		if math.Abs(res.Statistic-tt.z) > 1e-6 || math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+			t.Errorf("DunnTest()[%v] z = %v, p = %v, want %v, %v", k, res.Statistic, res.PValue, tt.z, tt.pvalue)
+		}
+		if math.Abs(res.AdjustedPValue-tt.adjusted) > 1e-6 || res.Accepted != tt.accepted {
+			t.Errorf("DunnTest()[%v] adjusted p = %v, Accepted = %v, want %v, %v", k, res.AdjustedPValue, res.Accepted, tt.adjusted, tt.accepted)
+		}
+	}
+
+	// Controlling the false discovery rate instead rejects the last pair too.
+	bh := DunnTest(0.05, BenjaminiHochberg, groups...)
+	for k, want := range []float64{0.139256, 0.000769, 0.037786} {
+		if math.Abs(bh[k].AdjustedPValue-want) > 1e-6 || bh[k].PValue != got[k].PValue {
+			t.Errorf("DunnTest(BenjaminiHochberg)[%v] adjusted p = %v, want %v", k, bh[k].AdjustedPValue, want)
+		}
+	}
+	if !bh[0].Accepted || bh[1].Accepted || bh[2].Accepted {
+		t.Errorf("DunnTest(BenjaminiHochberg) Accepted = %v, %v, %v, want true, false, false", bh[0].Accepted, bh[1].Accepted, bh[2].Accepted)
+	}
+}
+
+func Test_mannWhitneyDistribution(t *testing.T) {
+	tests := []struct {
+		name   string
+		n1, n2 int
+		want   []float64
+	}{
+		{"1x1 case", 1, 1, []float64{0.5, 0.5}},
+		{"2x2 case", 2, 2, []float64{1.0 / 6, 1.0 / 6, 2.0 / 6, 1.0 / 6, 1.0 / 6}},
+		{"1x3 case", 1, 3, []float64{0.25, 0.25, 0.25, 0.25}},
+		{"2x3 case", 3, 2, []float64{0.1, 0.1, 0.2, 0.2, 0.2, 0.1, 0.1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mannWhitneyDistribution(tt.n1, tt.n2)
+			if len(got) != len(tt.want) {
+				t.Fatalf("mannWhitneyDistribution() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("mannWhitneyDistribution() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_signedRankDistribution(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []float64
+	}{
+		{"Empty case", 0, []float64{1}},
+		{"1 case", 1, []float64{0.5, 0.5}},
+		{"3 case", 3, []float64{0.125, 0.125, 0.125, 0.25, 0.125, 0.125, 0.125}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := signedRankDistribution(tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("signedRankDistribution() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("signedRankDistribution() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_rank(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		ranks  []float64
+		ties   float64
+	}{
+		{"No ties case", []float64{3, 1, 2}, []float64{3, 1, 2}, 0},
+		{"Ties case", []float64{2, 1, 2, 3, 2, 1}, []float64{4, 1.5, 4, 6, 4, 1.5}, 30},
+		{"Empty case", []float64{}, []float64{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranks, ties := rank(tt.values)
+			if len(ranks) != len(tt.ranks) || ties != tt.ties {
+				t.Fatalf("rank() = %v, %v, want %v, %v", ranks, ties, tt.ranks, tt.ties)
+			}
+			for i := range ranks {
+				if ranks[i] != tt.ranks[i] {
+					t.Errorf("rank() = %v, want %v", ranks, tt.ranks)
+				}
+			}
+		})
+	}
+}
+
+func Test_sortedMatrix(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 30} {
+		a := make([]float64, n)
+		b := make([]float64, n+3)
+		for i := range a {
+			a[i] = float64(rnd.Intn(10))
+		}
+		for i := range b {
+			b[i] = float64(rnd.Intn(10))
+		}
+		x, y := sortedCopy(a), sortedCopy(b)
+		diffs := sortedMatrix{rows: len(x), cols: len(y), first: func(i int) int { return 0 },
+			value: func(i int, j int) float64 { return x[i] - y[len(y)-1-j] }}
+		walsh := sortedMatrix{rows: len(x), cols: len(x), first: func(i int) int { return i },
+			value: func(i int, j int) float64 { return (x[i] + x[j]) / 2 }}
+
+		var wantDiffs, wantWalsh []float64
+		for i := range x {
+			for j := range y {
+				wantDiffs = append(wantDiffs, x[i]-y[j])
+			}
+			for j := i; j < len(x); j++ {
+				wantWalsh = append(wantWalsh, (x[i]+x[j])/2)
+			}
+		}
+		for name, tt := range map[string]struct {
+			m    sortedMatrix
+			want []float64
+		}{"differences": {diffs, sortedCopy(wantDiffs)}, "Walsh averages": {walsh, sortedCopy(wantWalsh)}} {
+			if got := tt.m.size(); got != len(tt.want) {
+				t.Fatalf("%v size() = %v, want %v", name, got, len(tt.want))
+			}
+			for k, want := range tt.want {
+				if got := tt.m.selectK(k); got != want {
+					t.Errorf("%v of %d values selectK(%d) = %v, want %v", name, n, k, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMannWhitneyUTest_Shift(t *testing.T) {
+	if got := MannWhitneyUTest([]float64{5}, []float64{2}, 0.05, TailBoth).Shift; got != 3 {
+		t.Errorf("Shift of single values = %v, want 3", got)
+	}
+	// The differences of large samples are never built, so this runs in little time and memory.
+	rnd := rand.New(rand.NewSource(2))
+	a := make([]float64, 20000)
+	b := make([]float64, 20000)
+	for i := range a {
+		a[i] = rnd.NormFloat64() + 1
+		b[i] = rnd.NormFloat64()
+	}
+	if got := MannWhitneyUTest(a, b, 0.05, TailBoth).Shift; math.Abs(got-1) > 0.02 {
+		t.Errorf("Shift of large samples = %v, want about 1", got)
+	}
+	if got := WilcoxonSignedRank(a, b, ZeroWilcox, 0.05, TailBoth).PseudoMedian; math.Abs(got-1) > 0.02 {
+		t.Errorf("PseudoMedian of large samples = %v, want about 1", got)
+	}
+}
@@ -0,0 +1,217 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// ShapiroWilk performs the Shapiro-Wilk test of normality with Royston's algorithm (AS R94), for 3 to 5000 values.
+// W is close to 1 for normal samples, so the p-value is the probability of a smaller W.
+// Null hypothesis Ho : the sample comes from a normal distribution.
+// Alternative hypothesis H1 : the sample does not come from a normal distribution.
+func ShapiroWilk(sample []float64, alpha float64) TestResult {
+	n := len(sample)
+	if n < 3 || n > 5000 {
+		panic("stats: the sample size must be between 3 and 5000")
+	}
+	x := make([]float64, n)
+	copy(x, sample)
+	sort.Float64s(x)
+	if x[n-1]-x[0] == 0 {
+		panic("stats: all the values are identical")
+	}
+
+	a := shapiroWilkCoefficients(n)
+	num := 0.0
+	for i, c := range a {
+		num += c * (x[n-1-i] - x[i])
+	}
+	w := math.Min(1, num*num/sumOfSquaredDifferences(x))
+
+	return newTestResult("Shapiro-Wilk test", "W", w, shapiroWilkPValue(w, n), alpha, TailLeft)
+}
+
+// shapiroWilkCoefficients returns the n/2 first coefficients of the W statistic, the others being their opposites.
+func shapiroWilkCoefficients(n int) []float64 {
+	c1 := []float64{0, 0.221157, -0.147981, -2.07119, 4.434685, -2.706056}
+	c2 := []float64{0, 0.042981, -0.293762, -1.752461, 5.682633, -3.582633}
+
+	nn2 := n / 2
+	a := make([]float64, nn2)
+	if n == 3 {
+		a[0] = math.Sqrt(0.5)
+		return a
+	}
+	an := float64(n)
+	m := make([]float64, nn2)
+	summ2 := 0.0
+	std := pd.StandardNormal()
+	for i := range m {
+		m[i] = std.Quantile((float64(i+1) - 0.375) / (an + 0.25))
+		summ2 += m[i] * m[i]
+	}
+	summ2 *= 2
+	ssumm2 := math.Sqrt(summ2)
+	rsn := 1 / math.Sqrt(an)
+	a[0] = polynomial(c1, rsn) - m[0]/ssumm2
+
+	first := 1
+	fac := math.Sqrt((summ2 - 2*m[0]*m[0]) / (1 - 2*a[0]*a[0]))
+	if n > 5 {
+		first = 2
+		a[1] = polynomial(c2, rsn) - m[1]/ssumm2
+		fac = math.Sqrt((summ2 - 2*m[0]*m[0] - 2*m[1]*m[1]) / (1 - 2*a[0]*a[0] - 2*a[1]*a[1]))
+	}
+	for i := first; i < nn2; i++ {
+		a[i] = -m[i] / fac
+	}
+	return a
+}
+
+// shapiroWilkPValue returns the p-value of W for a sample of size n, from Royston's normalizing transformations.
+func shapiroWilkPValue(w float64, n int) float64 {
+	if n == 3 {
+		// The distribution of W is known exactly for three values.
+		return math.Max(0, 6/math.Pi*(math.Asin(math.Sqrt(w))-math.Pi/3))
+	}
+	an := float64(n)
+	y := math.Log1p(-w)
+	var mean, sd float64
+	if n <= 11 {
+		gamma := -2.273 + 0.459*an
+		if y >= gamma {
+			return 0
+		}
+		y = -math.Log(gamma - y)
+		mean = polynomial([]float64{0.544, -0.39978, 0.025054, -6.714e-4}, an)
+		sd = math.Exp(polynomial([]float64{1.3822, -0.77857, 0.062767, -0.0020322}, an))
+	} else {
+		ln := math.Log(an)
+		mean = polynomial([]float64{-1.5861, -0.31082, -0.083751, 0.0038915}, ln)
+		sd = math.Exp(polynomial([]float64{-0.4803, -0.082676, 0.0030302}, ln))
+	}
+	return pd.Normal{Mu: mean, Sigma: sd}.Survival(y)
+}
+
+// polynomial returns c[0] + c[1]*x + c[2]*x^2 + ...
+func polynomial(c []float64, x float64) float64 {
+	p := 0.0
+	for i := len(c) - 1; i >= 0; i-- {
+		p = p*x + c[i]
+	}
+	return p
+}
+
+// AndersonDarling performs the Anderson-Darling test of normality, with the mean and standard deviation estimated from
+// the sample. The statistic is the modified A² = A²(1 + 0.75/n + 2.25/n²) and the p-value comes from the
+// approximation of D'Agostino and Stephens. The sample needs at least 8 values.
+// Null hypothesis Ho : the sample comes from a normal distribution.
+// Alternative hypothesis H1 : the sample does not come from a normal distribution.
+func AndersonDarling(sample []float64, alpha float64) TestResult {
+	n := len(sample)
+	if n < 8 {
+		panic("stats: the sample size must be at least 8")
+	}
+	x := make([]float64, n)
+	copy(x, sample)
+	sort.Float64s(x)
+	dist := pd.Normal{Mu: Mean(x), Sigma: StdDev(x)}
+	if !(dist.Sigma > 0) {
+		panic("stats: all the values are identical")
+	}
+
+	nf := float64(n)
+	s := 0.0
+	for i := range x {
+		// log(1 - CDF(x)) is computed as LogCDF of the reflected value to keep its precision in the right tail.
+		s += float64(2*i+1) * (dist.LogCDF(x[i]) + dist.LogCDF(2*dist.Mu-x[n-1-i]))
+	}
+	a2 := (-nf - s/nf) * (1 + 0.75/nf + 2.25/(nf*nf))
+
+	var pvalue float64
+	switch {
+	case a2 < 0.2:
+		pvalue = 1 - math.Exp(-13.436+101.14*a2-223.73*a2*a2)
+	case a2 < 0.34:
+		pvalue = 1 - math.Exp(-8.318+42.796*a2-59.938*a2*a2)
+	case a2 < 0.6:
+		pvalue = math.Exp(0.9177 - 4.279*a2 - 1.38*a2*a2)
+	case a2 < 10:
+		pvalue = math.Exp(1.2937 - 5.709*a2 + 0.0186*a2*a2)
+	default:
+		// The last approximation grows again past its minimum, so the p-value is floored as in R's nortest.
+		pvalue = 3.7e-24
+	}
+	return newTestResult("Anderson-Darling test", "A²", a2, math.Min(1, pvalue), alpha, TailRight)
+}
+
+// JarqueBera performs the Jarque-Bera test of normality, JB = n/6 (S² + (K-3)²/4), where S and K are the sample
+// skewness and kurtosis. The p-value comes from the chi-squared distribution with 2 degrees of freedom,
+// which is only reached for large samples.
+// Null hypothesis Ho : the sample comes from a normal distribution.
+// Alternative hypothesis H1 : the sample does not come from a normal distribution.
+func JarqueBera(sample []float64, alpha float64) TestResult {
+	if len(sample) < 2 {
+		panic("stats: incorrect samples length")
+	}
+	n := float64(len(sample))
+	skewness, kurtosis := shapeMoments(sample)
+	jb := n / 6 * (skewness*skewness + (kurtosis-3)*(kurtosis-3)/4)
+	dist := pd.ChiSquared{K: 2}
+	res := newTestResult("Jarque-Bera test", "JB", jb, dist.Survival(jb), alpha, TailRight)
+	res.DF = 2
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	return res
+}
+
+// DAgostinoK2 performs D'Agostino's K² omnibus test of normality. K² = Z1² + Z2² where Z1 and Z2 are the
+// normalizing transformations of the sample skewness and kurtosis of D'Agostino, Belanger and D'Agostino (1990).
+// The p-value comes from the chi-squared distribution with 2 degrees of freedom. The sample needs at least 8 values,
+// and 20 for the kurtosis transformation to be accurate.
+// Null hypothesis Ho : the sample comes from a normal distribution.
+// Alternative hypothesis H1 : the sample does not come from a normal distribution.
+func DAgostinoK2(sample []float64, alpha float64) TestResult {
+	if len(sample) < 8 {
+		panic("stats: the sample size must be at least 8")
+	}
+	n := float64(len(sample))
+	skewness, kurtosis := shapeMoments(sample)
+
+	y := skewness * math.Sqrt((n+1)*(n+3)/(6*(n-2)))
+	beta2 := 3 * (n*n + 27*n - 70) * (n + 1) * (n + 3) / ((n - 2) * (n + 5) * (n + 7) * (n + 9))
+	w2 := -1 + math.Sqrt(2*(beta2-1))
+	delta := 1 / math.Sqrt(0.5*math.Log(w2))
+	z1 := delta * math.Asinh(y/math.Sqrt(2/(w2-1)))
+
+	e := 3 * (n - 1) / (n + 1)
+	varb2 := 24 * n * (n - 2) * (n - 3) / ((n + 1) * (n + 1) * (n + 3) * (n + 5))
+	x := (kurtosis - e) / math.Sqrt(varb2)
+	sqrtBeta1 := 6 * (n*n - 5*n + 2) / ((n + 7) * (n + 9)) * math.Sqrt(6*(n+3)*(n+5)/(n*(n-2)*(n-3)))
+	a := 6 + 8/sqrtBeta1*(2/sqrtBeta1+math.Sqrt(1+4/(sqrtBeta1*sqrtBeta1)))
+	denom := 1 + x*math.Sqrt(2/(a-4))
+	z2 := (1 - 2/(9*a) - math.Cbrt((1-2/a)/denom)) / math.Sqrt(2/(9*a))
+
+	k2 := z1*z1 + z2*z2
+	dist := pd.ChiSquared{K: 2}
+	res := newTestResult("D'Agostino K² test", "K²", k2, dist.Survival(k2), alpha, TailRight)
+	res.DF = 2
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	return res
+}
+
+// shapeMoments returns the sample skewness m3/m2^(3/2) and kurtosis m4/m2², computed from the biased central moments.
+func shapeMoments(sample []float64) (float64, float64) {
+	mean := Mean(sample)
+	var m2, m3, m4 float64
+	for _, x := range sample {
+		d := x - mean
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+	n := float64(len(sample))
+	m2, m3, m4 = m2/n, m3/n, m4/n
+	return m3 / math.Pow(m2, 1.5), m4 / (m2 * m2)
+}
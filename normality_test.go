@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// normalityCases returns samples shared by the normality tests: the weights of Shapiro and Wilk (1965),
+// a sample that looks normal, and 500 quantiles of a normal and of an exponential distribution.
+func normalityCases() (weights, small, normal, skewed []float64) {
+	weights = []float64{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236}
+	small = []float64{2.1, 3.4, 1.9, 5.6, 4.4, 3.8, 2.9, 4.1, 3.3, 3.6, 2.7, 4.9, 3.1, 3.9, 4.6, 2.2, 3.5, 3.0, 4.2, 3.7}
+	std := pd.StandardNormal()
+	for i := 0; i < 500; i++ {
+		p := (float64(i) + 0.5) / 500
+		normal = append(normal, std.Quantile(p))
+		skewed = append(skewed, -math.Log1p(-p))
+	}
+	return
+}
+
+type normalityCase struct {
+	name      string
+	sample    []float64
+	statistic float64
+	pvalue    float64
+	accepted  bool
+}
+
+func checkNormalityTest(t *testing.T, test func([]float64, float64) TestResult, tests []normalityCase) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := test(tt.sample, 0.05)
+			if math.Abs(res.Statistic-tt.statistic) > 1e-6 {
+				t.Errorf("Statistic = %v, want %v", res.Statistic, tt.statistic)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if res.Accepted != tt.accepted {
+				t.Errorf("Accepted = %v, want %v", res.Accepted, tt.accepted)
+			}
+		})
+	}
+}
+
+func TestShapiroWilk(t *testing.T) {
+	weights, small, normal, skewed := normalityCases()
+	checkNormalityTest(t, ShapiroWilk, []normalityCase{
+		{"Three values case", []float64{1, 2, 4}, 0.964286, 0.636887, true},
+		{"Five values case", []float64{1, 2, 4, 7, 8}, 0.922879, 0.548681, true},
+		{"Weights case", weights, 0.788815, 0.006704, false},
+		{"Small normal case", small, 0.987266, 0.992172, true},
+		{"Normal quantiles case", normal, 0.999901, 1, true},
+		{"Exponential quantiles case", skewed, 0.819705, 0, false},
+	})
+}
+
+func Test_shapiroWilkCoefficients(t *testing.T) {
+	// Table 5 of Shapiro and Wilk (1965) gives the coefficients to four decimals.
+	want := []float64{0.5601, 0.3315, 0.2260, 0.1429, 0.0695}
+	got := shapiroWilkCoefficients(11)
+	if len(got) != len(want) {
+		t.Fatalf("shapiroWilkCoefficients() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-3 {
+			t.Errorf("shapiroWilkCoefficients() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestAndersonDarling(t *testing.T) {
+	weights, small, normal, skewed := normalityCases()
+	checkNormalityTest(t, AndersonDarling, []normalityCase{
+		{"Weights case", weights, 1.028930, 0.010454, false},
+		{"Small normal case", small, 0.106606, 0.994464, true},
+		{"Normal quantiles case", normal, 0.002851, 0.999998, true},
+		{"Exponential quantiles case", skewed, 23.189742, 0, false},
+	})
+}
+
+func TestAndersonDarling_Large(t *testing.T) {
+	// A large lognormal sample gives an A² far beyond the range of the p-value approximation.
+	rnd := rand.New(rand.NewSource(1))
+	sample := make([]float64, 5000)
+	for i := range sample {
+		sample[i] = math.Exp(2 * rnd.NormFloat64())
+	}
+	res := AndersonDarling(sample, 0.05)
+	if res.Statistic < 100 || res.PValue != 3.7e-24 || res.Accepted {
+		t.Errorf("AndersonDarling() = A² %v, p %v, accepted %v, want A² > 100, p 3.7e-24, rejected", res.Statistic, res.PValue, res.Accepted)
+	}
+}
+
+func TestJarqueBera(t *testing.T) {
+	weights, small, normal, skewed := normalityCases()
+	checkNormalityTest(t, JarqueBera, []normalityCase{
+		{"Weights case", weights, 6.982848, 0.030457, false},
+		{"Small normal case", small, 0.181089, 0.913434, true},
+		{"Normal quantiles case", normal, 0.049000, 0.975798, true},
+		{"Exponential quantiles case", skewed, 826.295297, 0, false},
+	})
+}
+
+func TestDAgostinoK2(t *testing.T) {
+	weights, small, normal, skewed := normalityCases()
+	checkNormalityTest(t, DAgostinoK2, []normalityCase{
+		{"Weights case", weights, 13.034263, 0.001478, false},
+		{"Small normal case", small, 0.137454, 0.933581, true},
+		{"Normal quantiles case", normal, 0.005242, 0.997383, true},
+		{"Exponential quantiles case", skewed, 209.717471, 0, false},
+	})
+}
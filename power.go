@@ -0,0 +1,224 @@
+package stats
+
+import (
+	"errors"
+	"math"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// PowerParams holds the four quantities linked by a power analysis.
+// The solvers compute the one selected by Solve from the three others, whatever its value.
+type PowerParams struct {
+	// EffectSize is the standardized effect size: Cohen's d for z and t tests, Cohen's h for proportions,
+	// Cohen's f for ANOVA and Cohen's w for chi-squared tests. It is negative for left tailed tests.
+	EffectSize float64
+	// Alpha is the significance level.
+	Alpha float64
+	// Power is the probability of rejecting the null hypothesis when the true effect is EffectSize.
+	Power float64
+	// N is the number of values for one sample designs, of pairs for paired designs and of values per group otherwise.
+	// It is fractional when it is solved for and should be rounded up.
+	N float64
+	// Solve is the quantity to compute, the sample size N by default.
+	Solve PowerUnknown
+}
+
+// PowerUnknown represents the quantity a power analysis solves for.
+type PowerUnknown uint8
+
+const (
+	// SolveN computes the sample size N that reaches Power.
+	SolveN PowerUnknown = iota
+	// SolvePower computes the power of the test, which is Alpha for an EffectSize of 0.
+	SolvePower
+	// SolveEffectSize computes the smallest effect size detected with Power.
+	SolveEffectSize
+	// SolveAlpha computes the significance level that reaches Power.
+	SolveAlpha
+)
+
+// PowerDesign represents the design of a z, t or proportion test in a power analysis.
+type PowerDesign uint8
+
+const (
+	// DesignOneSample compares one sample with a known value, as in OneSampleTTest.
+	DesignOneSample PowerDesign = iota
+	// DesignPaired compares paired observations, as in PairedTTest. EffectSize is the one of the differences.
+	DesignPaired
+	// DesignTwoSample compares two independent samples of N values each, as in TwoSampleTTest.
+	DesignTwoSample
+)
+
+// powerMaxN is the largest sample size the solvers look for.
+const powerMaxN = 1e9
+
+// PowerZTest solves the power analysis of a z test with the given design, where EffectSize is Cohen's d,
+// the mean difference divided by the known standard deviation.
+func PowerZTest(params PowerParams, design PowerDesign, tails TailDirection) (PowerParams, error) {
+	return solvePower(params, 0, tails, func(es float64, alpha float64, n float64) float64 {
+		return normalPower(es*math.Sqrt(effectiveN(n, design)), alpha, tails)
+	})
+}
+
+// PowerTTest solves the power analysis of a t test with the given design, where EffectSize is Cohen's d,
+// the mean difference divided by the standard deviation. The power comes from the noncentral t distribution.
+func PowerTTest(params PowerParams, design PowerDesign, tails TailDirection) (PowerParams, error) {
+	return solvePower(params, 1, tails, func(es float64, alpha float64, n float64) float64 {
+		df := n - 1
+		if design == DesignTwoSample {
+			df = 2*n - 2
+		}
+		crit := criticalValues(pd.StudentsT{V: df}, alpha, tails)
+		dist := pd.NoncentralT{V: df, Mu: es * math.Sqrt(effectiveN(n, design))}
+		switch tails {
+		case TailRight:
+			return dist.Survival(crit[0])
+		case TailLeft:
+			return dist.CDF(crit[0])
+		default:
+			return dist.CDF(crit[0]) + dist.Survival(crit[1])
+		}
+	})
+}
+
+// PowerProportionTest solves the power analysis of a one or two sample test of proportions with the normal
+// approximation, where EffectSize is Cohen's h, see CohenH. DesignPaired is not supported.
+func PowerProportionTest(params PowerParams, design PowerDesign, tails TailDirection) (PowerParams, error) {
+	if design == DesignPaired {
+		return params, errors.New("stats: the paired design is not supported for proportions")
+	}
+	return PowerZTest(params, design, tails)
+}
+
+// CohenH returns Cohen's h, the effect size of the difference between the proportions p1 and p2:
+// 2 asin(sqrt(p1)) - 2 asin(sqrt(p2)).
+func CohenH(p1 float64, p2 float64) float64 {
+	return 2*math.Asin(math.Sqrt(p1)) - 2*math.Asin(math.Sqrt(p2))
+}
+
+// PowerANOVA solves the power analysis of a one-way ANOVA with the given number of groups of N values each,
+// where EffectSize is Cohen's f, the standard deviation of the group means divided by the common standard deviation.
+// The power comes from the noncentral F distribution with noncentrality groups * N * f².
+func PowerANOVA(params PowerParams, groups int) (PowerParams, error) {
+	if groups < 2 {
+		return params, errors.New("stats: at least two groups are needed")
+	}
+	k := float64(groups)
+	return solvePower(params, 1, TailRight, func(es float64, alpha float64, n float64) float64 {
+		crit := pd.F{D1: k - 1, D2: k * (n - 1)}.Quantile(1 - alpha)
+		return pd.NoncentralF{D1: k - 1, D2: k * (n - 1), Lambda: k * n * es * es}.Survival(crit)
+	})
+}
+
+// PowerChiSquareTest solves the power analysis of a chi-squared test with df degrees of freedom, where EffectSize
+// is Cohen's w and N the total count. The power comes from the noncentral chi-squared distribution with
+// noncentrality N * w².
+func PowerChiSquareTest(params PowerParams, df float64) (PowerParams, error) {
+	if !(df > 0) {
+		return params, errors.New("stats: the degrees of freedom must be > 0")
+	}
+	return solvePower(params, 0, TailRight, func(es float64, alpha float64, n float64) float64 {
+		crit := pd.ChiSquared{K: df}.Quantile(1 - alpha)
+		return pd.NoncentralChiSquared{K: df, Lambda: n * es * es}.Survival(crit)
+	})
+}
+
+// effectiveN returns the number of values that scales the effect size of the design into the noncentrality.
+func effectiveN(n float64, design PowerDesign) float64 {
+	if design == DesignTwoSample {
+		return n / 2
+	}
+	return n
+}
+
+// normalPower returns the power of a z test whose statistic follows N(ncp, 1).
+func normalPower(ncp float64, alpha float64, tails TailDirection) float64 {
+	std := pd.StandardNormal()
+	crit := criticalValues(std, alpha, tails)
+	switch tails {
+	case TailRight:
+		return std.Survival(crit[0] - ncp)
+	case TailLeft:
+		return std.CDF(crit[0] - ncp)
+	default:
+		return std.CDF(crit[0]-ncp) + std.Survival(crit[1]-ncp)
+	}
+}
+
+// solvePower fills the field of params selected by Solve using power, which returns the power for an effect size, a significance
+// level and a sample size greater than nMin. The power must increase with each of them, the effect size being taken
+// negative for left tailed tests.
+func solvePower(params PowerParams, nMin float64, tails TailDirection, power func(es float64, alpha float64, n float64) float64) (PowerParams, error) {
+	if tails > TailBoth {
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+	if params.Solve > SolveAlpha {
+		panic("stats: incorrect power unknown. Try SolveN, SolvePower, SolveEffectSize or SolveAlpha")
+	}
+	if params.Solve != SolveAlpha && !(params.Alpha > 0 && params.Alpha < 1) {
+		return params, errors.New("stats: invalid power parameters. Check 0 < Alpha < 1")
+	}
+	if params.Solve != SolvePower && !(params.Power > 0 && params.Power < 1) {
+		return params, errors.New("stats: invalid power parameters. Check 0 < Power < 1")
+	}
+	if params.Solve != SolveN && !(params.N > nMin && params.N <= powerMaxN) {
+		return params, errors.New("stats: invalid power parameters. Check N is large enough")
+	}
+	if params.Solve != SolveEffectSize && (math.IsNaN(params.EffectSize) || math.IsInf(params.EffectSize, 0)) {
+		return params, errors.New("stats: invalid power parameters. Check EffectSize is finite")
+	}
+
+	unreachable := errors.New("stats: the power cannot be reached with these parameters")
+	switch params.Solve {
+	case SolvePower:
+		params.Power = power(params.EffectSize, params.Alpha, params.N)
+	case SolveN:
+		f := func(n float64) float64 { return power(params.EffectSize, params.Alpha, n) - params.Power }
+		hi := nMin + 1
+		for f(hi) < 0 {
+			if hi >= powerMaxN {
+				return params, unreachable
+			}
+			hi = math.Min(powerMaxN, 2*hi)
+		}
+		params.N = bisectIncreasing(f, nMin, hi)
+	case SolveAlpha:
+		f := func(alpha float64) float64 { return power(params.EffectSize, alpha, params.N) - params.Power }
+		if f(1-1e-12) < 0 {
+			return params, unreachable
+		}
+		params.Alpha = bisectIncreasing(f, 0, 1)
+	case SolveEffectSize:
+		sign := 1.0
+		if tails == TailLeft {
+			sign = -1
+		}
+		f := func(es float64) float64 { return power(sign*es, params.Alpha, params.N) - params.Power }
+		if f(0) >= 0 {
+			return params, errors.New("stats: the power must be greater than alpha")
+		}
+		hi := 1.0
+		for f(hi) < 0 {
+			if hi >= 1e3 {
+				return params, unreachable
+			}
+			hi *= 2
+		}
+		params.EffectSize = sign * bisectIncreasing(f, 0, hi)
+	}
+	return params, nil
+}
+
+// bisectIncreasing returns the root of the increasing function f in (lo, hi], where f(hi) >= 0.
+func bisectIncreasing(f func(float64) float64, lo float64, hi float64) float64 {
+	for i := 0; i < 200 && hi-lo > 1e-12*math.Max(1, math.Abs(hi)); i++ {
+		mid := lo + (hi-lo)/2
+		if f(mid) < 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2
+}
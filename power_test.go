@@ -0,0 +1,139 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// The reference sample sizes are the ones of the R package pwr.
+
+func TestPowerTTest(t *testing.T) {
+	tests := []struct {
+		name   string
+		params PowerParams
+		design PowerDesign
+		tails  TailDirection
+		want   PowerParams
+	}{
+		{"Two sample n case", PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8}, DesignTwoSample, TailBoth, PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8, N: 63.765610}},
+		{"One sample n case", PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8}, DesignOneSample, TailBoth, PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8, N: 33.367129}},
+		{"Paired n case", PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8}, DesignPaired, TailBoth, PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8, N: 33.367129}},
+		{"One sample power case", PowerParams{EffectSize: 0.5, Alpha: 0.05, N: 20, Solve: SolvePower}, DesignOneSample, TailBoth,
+			PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.564504, N: 20}},
+		{"Zero effect power case", PowerParams{EffectSize: 0, Alpha: 0.05, N: 20, Solve: SolvePower}, DesignTwoSample, TailBoth,
+			PowerParams{EffectSize: 0, Alpha: 0.05, Power: 0.05, N: 20}},
+		{"Left tail effect size case", PowerParams{Alpha: 0.05, Power: 0.8, N: 64, Solve: SolveEffectSize}, DesignTwoSample, TailLeft,
+			PowerParams{EffectSize: -0.441930, Alpha: 0.05, Power: 0.8, N: 64}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PowerTTest(tt.params, tt.design, tt.tails)
+			if err != nil {
+				t.Fatalf("PowerTTest() error = %v", err)
+			}
+			if !closePowerParams(got, tt.want, 1e-6) {
+				t.Errorf("PowerTTest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerTTest_RoundTrip(t *testing.T) {
+	full, err := PowerTTest(PowerParams{EffectSize: 0.4, Alpha: 0.05, N: 50, Solve: SolvePower}, DesignTwoSample, TailBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unknown := range []PowerUnknown{SolveEffectSize, SolveAlpha, SolveN} {
+		// The value of the solved field is ignored.
+		params := full
+		params.Solve = unknown
+		switch unknown {
+		case SolveEffectSize:
+			params.EffectSize = 0
+		case SolveAlpha:
+			params.Alpha = 0
+		case SolveN:
+			params.N = 0
+		}
+		got, err := PowerTTest(params, DesignTwoSample, TailBoth)
+		if err != nil {
+			t.Fatalf("PowerTTest() solving %v error = %v", unknown, err)
+		}
+		if !closePowerParams(got, full, 1e-6) {
+			t.Errorf("PowerTTest() solving %v = %+v, want %+v", unknown, got, full)
+		}
+	}
+}
+
+func TestPowerZTest(t *testing.T) {
+	got, err := PowerZTest(PowerParams{EffectSize: 0.5, Alpha: 0.05, Power: 0.8}, DesignOneSample, TailBoth)
+	if err != nil || math.Abs(got.N-31.395442) > 1e-6 {
+		t.Errorf("PowerZTest() = %+v, %v, want N = 31.395442", got, err)
+	}
+	// A right tailed z test has the closed form n = ((z_{1-alpha} + z_{power}) / d)².
+	got, err = PowerZTest(PowerParams{EffectSize: 0.3, Alpha: 0.05, Power: 0.9}, DesignOneSample, TailRight)
+	std := pd.StandardNormal()
+	want := math.Pow((std.Quantile(0.95)+std.Quantile(0.9))/0.3, 2)
+	if err != nil || math.Abs(got.N-want) > 1e-6 {
+		t.Errorf("PowerZTest() = %+v, %v, want N = %v", got, err, want)
+	}
+}
+
+func TestPowerProportionTest(t *testing.T) {
+	got, err := PowerProportionTest(PowerParams{EffectSize: 0.3, Alpha: 0.05, Power: 0.8}, DesignTwoSample, TailBoth)
+	if err != nil || math.Abs(got.N-174.419) > 1e-3 {
+		t.Errorf("PowerProportionTest() = %+v, %v, want N = 174.419", got, err)
+	}
+	if _, err := PowerProportionTest(PowerParams{EffectSize: 0.3, Alpha: 0.05, Power: 0.8}, DesignPaired, TailBoth); err == nil {
+		t.Errorf("PowerProportionTest() with a paired design should fail")
+	}
+	if got := CohenH(0.65, 0.45); math.Abs(got-0.404860) > 1e-6 {
+		t.Errorf("CohenH() = %v, want 0.404860", got)
+	}
+}
+
+func TestPowerANOVA(t *testing.T) {
+	got, err := PowerANOVA(PowerParams{EffectSize: 0.25, Alpha: 0.05, Power: 0.8}, 4)
+	if err != nil || math.Abs(got.N-44.599274) > 1e-6 {
+		t.Errorf("PowerANOVA() = %+v, %v, want N = 44.599274", got, err)
+	}
+	if _, err := PowerANOVA(PowerParams{EffectSize: 0.25, Alpha: 0.05, Power: 0.8}, 1); err == nil {
+		t.Errorf("PowerANOVA() with one group should fail")
+	}
+}
+
+func TestPowerChiSquareTest(t *testing.T) {
+	got, err := PowerChiSquareTest(PowerParams{EffectSize: 0.3, Alpha: 0.05, Power: 0.8}, 2)
+	if err != nil || math.Abs(got.N-107.052099) > 1e-6 {
+		t.Errorf("PowerChiSquareTest() = %+v, %v, want N = 107.052099", got, err)
+	}
+}
+
+func TestPower_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		params PowerParams
+	}{
+		{"Missing power case", PowerParams{EffectSize: 0.5, Alpha: 0.05}},
+		{"Invalid alpha case", PowerParams{EffectSize: 0.5, Alpha: 1.5, Power: 0.8}},
+		{"Invalid power case", PowerParams{EffectSize: 0.5, Alpha: 0.05, N: 10, Power: -1, Solve: SolveEffectSize}},
+		{"Invalid n case", PowerParams{EffectSize: 0.5, Alpha: 0.05, N: 1, Solve: SolvePower}},
+		{"Power below alpha case", PowerParams{Alpha: 0.05, Power: 0.01, N: 10, Solve: SolveEffectSize}},
+		{"Zero effect n case", PowerParams{EffectSize: 0, Alpha: 0.05, Power: 0.8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := PowerTTest(tt.params, DesignOneSample, TailBoth); err == nil {
+				t.Errorf("PowerTTest(%+v) should fail", tt.params)
+			}
+		})
+	}
+}
+
+// closePowerParams reports whether every field of got is within tol of want.
+func closePowerParams(got PowerParams, want PowerParams, tol float64) bool {
+	return math.Abs(got.EffectSize-want.EffectSize) <= tol && math.Abs(got.Alpha-want.Alpha) <= tol &&
+		math.Abs(got.Power-want.Power) <= tol && math.Abs(got.N-want.N) <= tol
+}
@@ -0,0 +1,133 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// Beta is used to represent the beta distribution parameters.
+// Alpha and Beta are the shape parameters and must be > 0.
+// Beta(1, 1) is the uniform distribution on [0, 1] and a common uninformative prior for a probability.
+type Beta struct {
+	Alpha float64
+	Beta  float64
+}
+
+// NewBeta is used to initialize beta parameters. What is different from
+// Beta type is that here the parameters are validated.
+// Alpha > 0 and Beta > 0, both real numbers.
+func NewBeta(alpha float64, beta float64) (Beta, error) {
+	if !(alpha > 0) || !(beta > 0) {
+		return Beta{}, errors.New("stats: invalid Beta parameters. Check Alpha > 0 and Beta > 0")
+	}
+	return Beta{Alpha: alpha, Beta: beta}, nil
+}
+
+// Update returns the posterior distribution after observing successes and failures in binomial trials.
+// The beta distribution is the conjugate prior of the binomial, so the posterior is
+// Beta(Alpha+successes, Beta+failures). The counts must be >= 0.
+func (b Beta) Update(successes float64, failures float64) (Beta, error) {
+	if !(successes >= 0) || !(failures >= 0) {
+		return Beta{}, errors.New("stats: invalid Beta update. Check successes >= 0 and failures >= 0")
+	}
+	return NewBeta(b.Alpha+successes, b.Beta+failures)
+}
+
+// CredibleInterval returns the equal-tailed interval that contains the given probability mass.
+// For example level = 0.95 returns the 2.5% and 97.5% quantiles. Both bounds are NaN if level is not in (0, 1).
+func (b Beta) CredibleInterval(level float64) Interval {
+	if !(level > 0) || !(level < 1) {
+		return Interval{Min: math.NaN(), Max: math.NaN()}
+	}
+	return Interval{Min: b.Quantile((1 - level) / 2), Max: b.Quantile((1 + level) / 2)}
+}
+
+// PDF returns the probability density function output of the beta distribution for a given x.
+func (b Beta) PDF(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < 0 || x > 1:
+		return 0
+	case x == 0:
+		return betaBoundaryDensity(b.Alpha, b.Beta)
+	case x == 1:
+		return betaBoundaryDensity(b.Beta, b.Alpha)
+	}
+	return math.Exp(b.LogPDF(x))
+}
+
+// betaBoundaryDensity returns the limit of the beta density at the bound whose exponent is a-1.
+func betaBoundaryDensity(a float64, b float64) float64 {
+	switch {
+	case a < 1:
+		return math.Inf(1)
+	case a == 1:
+		return b
+	default:
+		return 0
+	}
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the beta distribution for a given x.
+func (b Beta) LogPDF(x float64) float64 {
+	if x <= 0 || x >= 1 {
+		return math.Log(b.PDF(x))
+	}
+	lbeta := lgamma(b.Alpha) + lgamma(b.Beta) - lgamma(b.Alpha+b.Beta)
+	return (b.Alpha-1)*math.Log(x) + (b.Beta-1)*math.Log1p(-x) - lbeta
+}
+
+// CDF returns the cumulative distribution function output of the beta distribution for a given x.
+// It is the regularized incomplete beta function I_x(Alpha, Beta).
+func (b Beta) CDF(x float64) float64 {
+	return regIncBeta(b.Alpha, b.Beta, x)
+}
+
+// Survival returns the survival function output of the beta distribution for a given x.
+func (b Beta) Survival(x float64) float64 {
+	return regIncBeta(b.Beta, b.Alpha, 1-x)
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the beta distribution.
+func (b Beta) Quantile(p float64) float64 {
+	return invRegIncBeta(b.Alpha, b.Beta, p)
+}
+
+// Mean returns the mean of the beta distribution.
+func (b Beta) Mean() float64 {
+	return b.Alpha / (b.Alpha + b.Beta)
+}
+
+// Mode returns the mode of the beta distribution.
+// It returns NaN when the mode is not unique (Alpha <= 1 and Beta <= 1).
+func (b Beta) Mode() float64 {
+	switch {
+	case math.IsNaN(b.Alpha) || math.IsNaN(b.Beta):
+		return math.NaN()
+	case b.Alpha > 1 && b.Beta > 1:
+		return (b.Alpha - 1) / (b.Alpha + b.Beta - 2)
+	case b.Alpha <= 1 && b.Beta > 1:
+		return 0
+	case b.Alpha > 1 && b.Beta <= 1:
+		return 1
+	default:
+		return math.NaN()
+	}
+}
+
+// StdDev returns the standard deviation of the beta distribution.
+func (b Beta) StdDev() float64 {
+	return math.Sqrt(b.Variance())
+}
+
+// Variance returns the variance of the beta distribution.
+func (b Beta) Variance() float64 {
+	s := b.Alpha + b.Beta
+	return b.Alpha * b.Beta / (s * s * (s + 1))
+}
+
+// Support returns the interval where the beta distribution is defined.
+func (b Beta) Support() Interval {
+	return Interval{0, 1}
+}
@@ -0,0 +1,228 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_beta_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		b    Beta
+		args args
+		want float64
+	}{
+		{"NaN case", Beta{2, 3}, args{math.NaN()}, math.NaN()},
+		{"Out of support case", Beta{2, 3}, args{1.5}, 0.0},
+		{"Uniform case", Beta{1, 1}, args{0.3}, 1.0},
+		{"Normal case", Beta{2, 3}, args{0.4}, 1.728},
+		{"Lower bound case", Beta{1, 3}, args{0.0}, 3.0},
+		{"Upper bound case", Beta{2, 3}, args{1.0}, 0.0},
+		{"Unbounded case", Beta{0.5, 0.5}, args{0.0}, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.b.PDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("PDF() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_beta_CDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		b    Beta
+		args args
+		want float64
+	}{
+		{"Uniform case", Beta{1, 1}, args{0.3}, 0.3},
+		{"Normal case", Beta{2, 3}, args{0.4}, 0.5248},
+		{"Arcsine case", Beta{0.5, 0.5}, args{0.25}, 1.0 / 3},
+		{"Lower bound case", Beta{2, 3}, args{-1.0}, 0.0},
+		{"Upper bound case", Beta{2, 3}, args{2.0}, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.CDF(tt.args.x); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.CDF(tt.args.x) + tt.b.Survival(tt.args.x); math.Abs(got-1) > 1e-12 {
+				t.Errorf("CDF() + Survival() = %v, want 1", got)
+			}
+		})
+	}
+}
+
+func Test_beta_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		b    Beta
+		args args
+		want float64
+	}{
+		{"Invalid case", Beta{2, 3}, args{1.5}, math.NaN()},
+		{"Uniform case", Beta{1, 1}, args{0.3}, 0.3},
+		{"Normal case", Beta{2, 3}, args{0.5248}, 0.4},
+		{"Arcsine case", Beta{0.5, 0.5}, args{1.0 / 3}, 0.25},
+		{"Symmetric case", Beta{20, 20}, args{0.5}, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.b.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-10 {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_beta_Moments(t *testing.T) {
+	tests := []struct {
+		name     string
+		b        Beta
+		mean     float64
+		variance float64
+		mode     float64
+	}{
+		{"Normal case", Beta{2, 3}, 0.4, 0.04, 1.0 / 3},
+		{"Uniform case", Beta{1, 1}, 0.5, 1.0 / 12, math.NaN()},
+		{"Decreasing case", Beta{1, 3}, 0.25, 0.0375, 0.0},
+		{"Increasing case", Beta{3, 0.5}, 3 / 3.5, 1.5 / (3.5 * 3.5 * 4.5), 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.Mean(); math.Abs(got-tt.mean) > 1e-12 {
+				t.Errorf("Mean() = %v, want %v", got, tt.mean)
+			}
+			if got := tt.b.Variance(); math.Abs(got-tt.variance) > 1e-12 {
+				t.Errorf("Variance() = %v, want %v", got, tt.variance)
+			}
+			if got := tt.b.StdDev(); math.Abs(got-math.Sqrt(tt.variance)) > 1e-12 {
+				t.Errorf("StdDev() = %v, want %v", got, math.Sqrt(tt.variance))
+			}
+			got := tt.b.Mode()
+			if math.IsNaN(got) != math.IsNaN(tt.mode) || (!math.IsNaN(got) && math.Abs(got-tt.mode) > 1e-12) {
+				t.Errorf("Mode() = %v, want %v", got, tt.mode)
+			}
+		})
+	}
+}
+
+func Test_beta_Update(t *testing.T) {
+	type args struct {
+		successes float64
+		failures  float64
+	}
+	tests := []struct {
+		name    string
+		b       Beta
+		args    args
+		want    Beta
+		wantErr bool
+	}{
+		{"Uniform prior case", Beta{1, 1}, args{42, 958}, Beta{43, 959}, false},
+		{"No data case", Beta{2, 3}, args{0, 0}, Beta{2, 3}, false},
+		{"Negative count case", Beta{1, 1}, args{-1, 3}, Beta{}, true},
+		{"NaN count case", Beta{1, 1}, args{math.NaN(), 3}, Beta{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Update(tt.args.successes, tt.args.failures)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_beta_CredibleInterval(t *testing.T) {
+	tests := []struct {
+		name   string
+		b      Beta
+		level  float64
+		wantLo float64
+		wantHi float64
+	}{
+		{"Uniform case", Beta{1, 1}, 0.9, 0.05, 0.95},
+		{"Arcsine case", Beta{0.5, 0.5}, 1.0 / 3, 0.25, 0.75},
+		{"Invalid case", Beta{2, 3}, 1.0, math.NaN(), math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ci := tt.b.CredibleInterval(tt.level)
+			lo, hi := ci.Min, ci.Max
+			if math.IsNaN(tt.wantLo) {
+				if !math.IsNaN(lo) || !math.IsNaN(hi) {
+					t.Errorf("CredibleInterval() = (%v, %v), want NaN", lo, hi)
+				}
+				return
+			}
+			if math.Abs(lo-tt.wantLo) > 1e-10 || math.Abs(hi-tt.wantHi) > 1e-10 {
+				t.Errorf("CredibleInterval() = (%v, %v), want (%v, %v)", lo, hi, tt.wantLo, tt.wantHi)
+			}
+		})
+	}
+	// Conversion rate posterior: the interval must hold 95% of the mass around the observed rate.
+	post, _ := Beta{1, 1}.Update(120, 880)
+	ci := post.CredibleInterval(0.95)
+	lo, hi := ci.Min, ci.Max
+	if got := post.CDF(hi) - post.CDF(lo); math.Abs(got-0.95) > 1e-10 {
+		t.Errorf("CDF(hi) - CDF(lo) = %v, want 0.95", got)
+	}
+	if !(lo < 0.12 && 0.12 < hi) {
+		t.Errorf("CredibleInterval() = (%v, %v) does not contain the observed rate", lo, hi)
+	}
+}
+
+func TestNewBeta(t *testing.T) {
+	type args struct {
+		alpha float64
+		beta  float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Beta
+		wantErr bool
+	}{
+		{"Normal case", args{2.0, 3.0}, Beta{2.0, 3.0}, false},
+		{"Invalid alpha case", args{0.0, 3.0}, Beta{}, true},
+		{"Invalid beta case", args{2.0, math.NaN()}, Beta{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBeta(tt.args.alpha, tt.args.beta)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewBeta() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewBeta() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
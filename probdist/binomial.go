@@ -23,6 +23,89 @@ func NewBinomial(n float64, p float64) (Binomial, error) {
 	return Binomial{N: n, P: p}, nil
 }
 
+// PMF returns the probability of getting exactly k successes in N trials.
+func (bin Binomial) PMF(k float64) float64 {
+	return math.Exp(bin.LogPMF(k))
+}
+
+// LogPMF returns the natural logarithm of the probability of getting exactly k successes in N trials.
+// It is computed through lgamma so that it does not overflow for large N.
+func (bin Binomial) LogPMF(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(bin.N) || math.IsNaN(bin.P) {
+		return math.NaN()
+	}
+	if k < 0 || k > bin.N || math.Mod(k, 1.0) != 0 {
+		return math.Inf(-1)
+	}
+	switch bin.P {
+	case 0:
+		if k == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	case 1:
+		if k == bin.N {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+	// C(N, k) = 1 / ((N+1) B(k+1, N-k+1)), whose log-beta does not cancel for large N.
+	lchoose := -math.Log(bin.N+1) - lbeta(k+1, bin.N-k+1)
+	return lchoose + k*math.Log(bin.P) + (bin.N-k)*math.Log1p(-bin.P)
+}
+
+// CDF returns the probability of getting at most k successes in N trials.
+// It is computed as the regularized incomplete beta function I_{1-P}(N-k, k+1).
+func (bin Binomial) CDF(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(bin.N) || math.IsNaN(bin.P) {
+		return math.NaN()
+	}
+	k = math.Floor(k)
+	switch {
+	case k < 0:
+		return 0
+	case k >= bin.N:
+		return 1
+	case bin.P == 0:
+		return 1
+	case bin.P == 1:
+		return 0
+	}
+	return regIncBeta(bin.N-k, k+1, 1-bin.P)
+}
+
+// Survival returns the probability of getting more than k successes in N trials.
+// It is computed as I_P(k+1, N-k) and not as 1 - CDF(k), so small upper tails are exact.
+func (bin Binomial) Survival(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(bin.N) || math.IsNaN(bin.P) {
+		return math.NaN()
+	}
+	k = math.Floor(k)
+	switch {
+	case k < 0:
+		return 1
+	case k >= bin.N:
+		return 0
+	case bin.P == 0:
+		return 0
+	case bin.P == 1:
+		return 1
+	}
+	return regIncBeta(k+1, bin.N-k, bin.P)
+}
+
+// Quantile returns the smallest number of successes k such that CDF(k) >= p.
+func (bin Binomial) Quantile(p float64) float64 {
+	start := math.Floor(bin.Mean() + bin.StdDev()*StandardNormal().Quantile(p))
+	return quantileDiscrete(bin.CDF, p, bin.Support(), start)
+}
+
+// Mode returns the most likely number of successes, floor((N+1)P).
+// When (N+1)P is an integer, (N+1)P-1 is a mode as well.
+func (bin Binomial) Mode() float64 {
+	return math.Min(math.Floor((bin.N+1)*bin.P), bin.N)
+}
+
 // Mean returns the mean of the binomial distribution.
 func (bin Binomial) Mean() float64 {
 	return bin.N * bin.P
@@ -37,3 +120,8 @@ func (bin Binomial) StdDev() float64 {
 func (bin Binomial) Variance() float64 {
 	return bin.Mean() * (1 - bin.P)
 }
+
+// Support returns the interval where the binomial distribution is defined.
+func (bin Binomial) Support() Interval {
+	return Interval{0, bin.N}
+}
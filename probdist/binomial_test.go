@@ -170,3 +170,158 @@ func BenchmarkNewBinomial(b *testing.B) {
 		NewBinomial(20.0, 0.5)
 	}
 }
+
+func Test_binomial_PMF(t *testing.T) {
+	type args struct {
+		k float64
+	}
+	tests := []struct {
+		name string
+		bin  Binomial
+		args args
+		want float64
+	}{
+		{"Normal case", Binomial{10, 0.5}, args{5}, 0.246094},
+		{"Lower bound case", Binomial{10, 0.2}, args{0}, 0.107374},
+		{"Upper bound case", Binomial{10, 0.2}, args{10}, 1.024e-7},
+		{"Out of support case", Binomial{10, 0.5}, args{11}, 0.0},
+		{"Non integer case", Binomial{10, 0.5}, args{2.5}, 0.0},
+		{"Large N case", Binomial{2000, 0.5}, args{1000}, 0.017839},
+		{"Degenerate p case", Binomial{10, 1.0}, args{10}, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bin.PMF(tt.args.k); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PMF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_binomial_CDF(t *testing.T) {
+	type args struct {
+		k float64
+	}
+	tests := []struct {
+		name string
+		bin  Binomial
+		args args
+		want float64
+	}{
+		{"Normal case", Binomial{10, 0.5}, args{5}, 0.623047},
+		{"Non integer case", Binomial{10, 0.5}, args{5.5}, 0.623047},
+		{"Negative case", Binomial{10, 0.5}, args{-1}, 0.0},
+		{"Upper bound case", Binomial{10, 0.5}, args{10}, 1.0},
+		{"Large N case", Binomial{1e6, 0.5}, args{5e5}, 0.500399},
+		{"Degenerate p case", Binomial{10, 0.0}, args{0}, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bin.CDF(tt.args.k); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_binomial_LogPMF(t *testing.T) {
+	bin := Binomial{1e7, 0.3}
+	// The expected values sum log((N-k+i)/i) for i up to k exactly, since the differences of log-gammas
+	// of the order of 1e8 lose the digits of the results near the mode.
+	tests := []struct {
+		k    float64
+		want float64
+	}{
+		{0, -3566749.4393873233},
+		{1000, -3557390.8197269044},
+		{2999000, -8.435677604284137},
+		{3e6, -8.197662516031414},
+		{5e6, -871775.2205630923},
+	}
+	for _, tt := range tests {
+		if got := bin.LogPMF(tt.k); math.Abs(got-tt.want) > 1e-12*math.Max(1, math.Abs(tt.want)) {
+			t.Errorf("LogPMF(%v) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+	if got := bin.LogPMF(-1); !math.IsInf(got, -1) {
+		t.Errorf("LogPMF(-1) = %v, want -Inf", got)
+	}
+}
+
+func Test_binomial_Survival(t *testing.T) {
+	tests := []struct {
+		name string
+		bin  Binomial
+		k    float64
+	}{
+		{"Flaky test case", Binomial{1000, 0.001}, 9},
+		{"Far tail case", Binomial{100, 0.5}, 90},
+		{"Lower tail case", Binomial{50, 0.9}, 10},
+		{"Center case", Binomial{30, 0.4}, 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := 0.0
+			for j := tt.bin.N; j > tt.k; j-- {
+				want += tt.bin.PMF(j)
+			}
+			if got := tt.bin.Survival(tt.k); math.Abs(got-want) > 1e-12*want {
+				t.Errorf("Survival() = %v, want %v", got, want)
+			}
+			if got := tt.bin.CDF(tt.k) + tt.bin.Survival(tt.k); math.Abs(got-1) > 1e-12 {
+				t.Errorf("CDF() + Survival() = %v, want 1", got)
+			}
+		})
+	}
+}
+
+func Test_binomial_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		bin  Binomial
+		args args
+		want float64
+	}{
+		{"NaN case", Binomial{10, 0.5}, args{math.NaN()}, math.NaN()},
+		{"Median case", Binomial{10, 0.5}, args{0.5}, 5},
+		{"Lower bound case", Binomial{10, 0.5}, args{0.0}, 0},
+		{"Upper bound case", Binomial{10, 0.5}, args{1.0}, 10},
+		{"Skewed case", Binomial{1000, 0.001}, args{0.99}, 4},
+		{"Large N case", Binomial{1e6, 0.5}, args{0.975}, 500980},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.bin.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_binomial_Mode(t *testing.T) {
+	tests := []struct {
+		name string
+		bin  Binomial
+		want float64
+	}{
+		{"Normal case", Binomial{10, 0.3}, 3},
+		{"Two modes case", Binomial{9, 0.5}, 5},
+		{"Lower bound case", Binomial{10, 0.0}, 0},
+		{"Upper bound case", Binomial{10, 1.0}, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bin.Mode(); got != tt.want {
+				t.Errorf("Mode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
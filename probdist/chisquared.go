@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// ChiSquared is used to represent the chi-squared distribution parameters.
+// K is the number of degrees of freedom and must be > 0.
+// It is a gamma distribution with shape K/2 and scale 2.
+type ChiSquared struct {
+	K float64
+}
+
+// NewChiSquared is used to initialize chi-squared parameters. What is different from
+// ChiSquared type is that here the parameters are validated.
+// K must be a real number > 0.
+func NewChiSquared(k float64) (ChiSquared, error) {
+	if !(k > 0) {
+		return ChiSquared{}, errors.New("stats: invalid ChiSquared parameters. Check K > 0")
+	}
+	return ChiSquared{K: k}, nil
+}
+
+// gamma returns the gamma distribution equivalent to the chi-squared distribution.
+func (chi ChiSquared) gamma() Gamma {
+	return Gamma{K: chi.K / 2, Theta: 2}
+}
+
+// PDF returns the probability density function output of the chi-squared distribution for a given x.
+func (chi ChiSquared) PDF(x float64) float64 {
+	return chi.gamma().PDF(x)
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the chi-squared distribution.
+func (chi ChiSquared) LogPDF(x float64) float64 {
+	return chi.gamma().LogPDF(x)
+}
+
+// CDF returns the cumulative distribution function output of the chi-squared distribution for a given x.
+func (chi ChiSquared) CDF(x float64) float64 {
+	return chi.gamma().CDF(x)
+}
+
+// Survival returns the survival function output of the chi-squared distribution for a given x.
+// This is the p-value of a chi-squared test statistic.
+func (chi ChiSquared) Survival(x float64) float64 {
+	return chi.gamma().Survival(x)
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the chi-squared distribution.
+func (chi ChiSquared) Quantile(p float64) float64 {
+	return chi.gamma().Quantile(p)
+}
+
+// Mean returns the mean of the chi-squared distribution.
+func (chi ChiSquared) Mean() float64 {
+	return chi.K
+}
+
+// StdDev returns the standard deviation of the chi-squared distribution.
+func (chi ChiSquared) StdDev() float64 {
+	return math.Sqrt(chi.Variance())
+}
+
+// Variance returns the variance of the chi-squared distribution.
+func (chi ChiSquared) Variance() float64 {
+	return 2 * chi.K
+}
+
+// Support returns the interval where the chi-squared distribution is defined.
+func (chi ChiSquared) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
@@ -0,0 +1,132 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_chiSquared_CDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		chi  ChiSquared
+		args args
+		want float64
+	}{
+		{"NaN case", ChiSquared{math.NaN()}, args{1.0}, math.NaN()},
+		{"Negative input case", ChiSquared{2}, args{-1.0}, 0.0},
+		{"Exponential case", ChiSquared{2}, args{2.0}, 1 - math.Exp(-1.0)},
+		{"One df case", ChiSquared{1}, args{3.841459}, 0.95},
+		{"Table case", ChiSquared{10}, args{18.307038}, 0.95},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.chi.CDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("CDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_chiSquared_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		chi  ChiSquared
+		args args
+		want float64
+	}{
+		{"Negative input case", ChiSquared{2}, args{-1.0}, 0.0},
+		{"Exponential case", ChiSquared{2}, args{2.0}, 0.5 * math.Exp(-1.0)},
+		{"Three df case", ChiSquared{3}, args{1.0}, 0.241971},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.chi.PDF(tt.args.x); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_chiSquared_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		chi  ChiSquared
+		args args
+		want float64
+	}{
+		{"Invalid case", ChiSquared{2}, args{2.0}, math.NaN()},
+		{"Exponential case", ChiSquared{2}, args{0.95}, -2 * math.Log(0.05)},
+		{"One df case", ChiSquared{1}, args{0.95}, 3.841459},
+		{"Table case", ChiSquared{10}, args{0.95}, 18.307038},
+		{"Table lower case", ChiSquared{10}, args{0.05}, 3.940299},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.chi.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_chiSquared_Moments(t *testing.T) {
+	chi := ChiSquared{8}
+	if got := chi.Mean(); got != 8 {
+		t.Errorf("Mean() = %v, want %v", got, 8)
+	}
+	if got := chi.Variance(); got != 16 {
+		t.Errorf("Variance() = %v, want %v", got, 16)
+	}
+	if got := chi.StdDev(); got != 4 {
+		t.Errorf("StdDev() = %v, want %v", got, 4)
+	}
+}
+
+func TestNewChiSquared(t *testing.T) {
+	type args struct {
+		k float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    ChiSquared
+		wantErr bool
+	}{
+		{"Normal case", args{3.0}, ChiSquared{3.0}, false},
+		{"Real case", args{2.5}, ChiSquared{2.5}, false},
+		{"Invalid zero case", args{0.0}, ChiSquared{}, true},
+		{"Invalid NaN case", args{math.NaN()}, ChiSquared{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChiSquared(tt.args.k)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChiSquared() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewChiSquared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
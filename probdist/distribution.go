@@ -0,0 +1,125 @@
+package stats
+
+import "math"
+
+// Interval represents the set of values a distribution can take.
+// Min and Max may be -Inf and +Inf for unbounded supports.
+type Interval struct {
+	Min float64
+	Max float64
+}
+
+// Distribution is the method set shared by every probability distribution in this package.
+type Distribution interface {
+	// CDF returns the probability P(X <= x).
+	CDF(x float64) float64
+	// Survival returns the probability P(X > x).
+	Survival(x float64) float64
+	// Quantile returns the inverse of the CDF for a probability p in [0, 1].
+	Quantile(p float64) float64
+	Mean() float64
+	Variance() float64
+	StdDev() float64
+	// Support returns the interval where the distribution is defined.
+	Support() Interval
+}
+
+// Continuous represents a continuous probability distribution.
+type Continuous interface {
+	Distribution
+	// PDF returns the probability density function output for a given x.
+	PDF(x float64) float64
+}
+
+// Discrete represents a discrete probability distribution whose support is a set of integers.
+type Discrete interface {
+	Distribution
+	// PMF returns the probability P(X = k).
+	PMF(k float64) float64
+}
+
+// quantileDiscrete returns the smallest integer k in the support such that cdf(k) >= p.
+// The search walks from start, which should be a guess close to the answer.
+func quantileDiscrete(cdf func(float64) float64, p float64, support Interval, start float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 1 {
+		return support.Max
+	}
+	k := math.Max(support.Min, math.Min(support.Max, start))
+	if math.IsNaN(k) || math.IsInf(k, 0) {
+		k = support.Min
+	}
+	c := cdf(k)
+	if math.IsNaN(c) {
+		return math.NaN()
+	}
+	if c >= p {
+		for k > support.Min && cdf(k-1) >= p {
+			k--
+		}
+		return k
+	}
+	for k < support.Max {
+		k++
+		next := cdf(k)
+		if math.IsNaN(next) {
+			return math.NaN()
+		}
+		// A flat CDF in the right tail means p is beyond floating point resolution.
+		if next >= p || (next == c && next > 0.5) {
+			return k
+		}
+		c = next
+	}
+	return support.Max
+}
+
+// quantileContinuous returns the x such that dist.CDF(x) = p, by bisection, for distributions whose quantile has
+// no closed form. The search starts from the interval guess ± scale, which is widened until it contains x.
+func quantileContinuous(dist Distribution, p float64, guess float64, scale float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	support := dist.Support()
+	if p == 0 {
+		return support.Min
+	}
+	if p == 1 {
+		return support.Max
+	}
+	// below reports whether x is below the quantile. The upper half is compared through Survival to keep its precision.
+	below := func(x float64) bool {
+		if p <= 0.5 {
+			return dist.CDF(x) < p
+		}
+		return dist.Survival(x) > 1-p
+	}
+	if math.IsNaN(guess) || math.IsInf(guess, 0) {
+		guess = math.Max(support.Min, 0)
+	}
+	if !(scale > 0) || math.IsInf(scale, 0) {
+		scale = 1
+	}
+	lo := math.Max(support.Min, guess-scale)
+	for i := 0; i < 1100 && lo > support.Min && !below(lo); i++ {
+		lo = math.Max(support.Min, guess-scale*math.Pow(2, float64(i+1)))
+	}
+	hi := math.Min(support.Max, guess+scale)
+	for i := 0; i < 1100 && hi < support.Max && below(hi); i++ {
+		hi = math.Min(support.Max, guess+scale*math.Pow(2, float64(i+1)))
+	}
+	for i := 0; i < 1100; i++ {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi || hi-lo <= 1e-15*math.Max(1, math.Abs(mid)) {
+			break
+		}
+		if below(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2
+}
@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	_ Continuous = Normal{}
+	_ Continuous = Exponential{}
+	_ Continuous = Gamma{}
+	_ Continuous = StudentsT{}
+	_ Continuous = ChiSquared{}
+	_ Continuous = F{}
+	_ Continuous = Beta{}
+	_ Continuous = Kolmogorov{}
+	_ Continuous = NoncentralT{}
+	_ Continuous = NoncentralChiSquared{}
+	_ Continuous = NoncentralF{}
+	_ Discrete   = Binomial{}
+	_ Discrete   = Poisson{}
+)
+
+func TestContinuous_QuantileInvertsCDF(t *testing.T) {
+	tests := []struct {
+		name string
+		dist Continuous
+	}{
+		{"Normal", Normal{2.0, 3.0}},
+		{"Exponential", Exponential{0.5}},
+		{"Gamma", Gamma{2.5, 1.5}},
+		{"StudentsT", StudentsT{4}},
+		{"ChiSquared", ChiSquared{3}},
+		{"F", F{5, 10}},
+		{"Beta", Beta{2.5, 7}},
+		{"Kolmogorov", Kolmogorov{}},
+		{"NoncentralT", NoncentralT{10, 1.5}},
+		{"NoncentralT negative", NoncentralT{4, -2}},
+		{"NoncentralChiSquared", NoncentralChiSquared{3, 4}},
+		{"NoncentralF", NoncentralF{3, 20, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range []float64{0.001, 0.05, 0.25, 0.5, 0.75, 0.95, 0.999} {
+				x := tt.dist.Quantile(p)
+				if got := tt.dist.CDF(x); math.Abs(got-p) > 1e-9 {
+					t.Errorf("CDF(Quantile(%v)) = %v", p, got)
+				}
+				if got := tt.dist.CDF(x) + tt.dist.Survival(x); math.Abs(got-1) > 1e-12 {
+					t.Errorf("CDF(%v) + Survival(%v) = %v, want 1", x, x, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDiscrete_QuantileInvertsCDF(t *testing.T) {
+	tests := []struct {
+		name string
+		dist Discrete
+	}{
+		{"Binomial", Binomial{20, 0.3}},
+		{"Poisson", Poisson{4.5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range []float64{0.001, 0.05, 0.25, 0.5, 0.75, 0.95, 0.999} {
+				k := tt.dist.Quantile(p)
+				if tt.dist.CDF(k) < p || tt.dist.CDF(k-1) >= p {
+					t.Errorf("Quantile(%v) = %v is not the smallest k with CDF(k) >= p", p, k)
+				}
+			}
+			sum := 0.0
+			for k := tt.dist.Support().Min; k <= 100; k++ {
+				sum += tt.dist.PMF(k)
+			}
+			if math.Abs(sum-1) > 1e-12 {
+				t.Errorf("sum of PMF = %v, want 1", sum)
+			}
+		})
+	}
+}
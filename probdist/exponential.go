@@ -21,12 +21,67 @@ func NewExponential(lambda float64) (Exponential, error) {
 	return Exponential{Lambda: lambda}, nil
 }
 
+// PDF returns the probability density function output for the exponential distribution.
+// It is 0 for x < 0.
+func (exp Exponential) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return exp.Lambda * math.Exp(-exp.Lambda*x)
+}
+
 // CDF returns the cumulative distribution function output for the exponential distribution.
+// It is 0 for x < 0.
 func (exp Exponential) CDF(x float64) float64 {
-	return 1 - math.Pow(math.E, -exp.Lambda*x)
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-exp.Lambda * x)
+}
+
+// Survival returns the survival function output for the exponential distribution.
+// It is 1 for x < 0.
+func (exp Exponential) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp(-exp.Lambda * x)
+}
+
+// Hazard returns the hazard function output for the exponential distribution, PDF(x)/Survival(x).
+// It is the constant Lambda on the support and 0 for x < 0.
+func (exp Exponential) Hazard(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < 0 {
+		return 0
+	}
+	return exp.Lambda
+}
+
+// ConditionalSurvival returns P(X > s+t | X > s). Because the exponential distribution is memoryless,
+// it equals Survival(t) for any s >= 0 and t >= 0.
+func (exp Exponential) ConditionalSurvival(s float64, t float64) float64 {
+	if math.IsNaN(s) || math.IsNaN(t) {
+		return math.NaN()
+	}
+	if t <= 0 {
+		return 1
+	}
+	// Survival(x) = exp(-Lambda*max(x, 0)), so the ratio is computed in log space.
+	return math.Exp(-exp.Lambda * (math.Max(s+t, 0) - math.Max(s, 0)))
 }
 
-// Mean returns the mean of the exponential distirbution.
+// Quantile returns the inverse of the cumulative distribution function of the exponential distribution.
+func (exp Exponential) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		return math.NaN()
+	}
+	return -math.Log1p(-p) / exp.Lambda
+}
+
+// Mean returns the mean of the exponential distribution.
 func (exp Exponential) Mean() float64 {
 	return 1 / exp.Lambda
 }
@@ -36,7 +91,12 @@ func (exp Exponential) StdDev() float64 {
 	return math.Sqrt(exp.Variance())
 }
 
-// Variance returns the standard deviation of the exponential distributon.
+// Variance returns the variance of the exponential distribution.
 func (exp Exponential) Variance() float64 {
 	return 1 / (exp.Lambda * exp.Lambda)
 }
+
+// Support returns the interval where the exponential distribution is defined.
+func (exp Exponential) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
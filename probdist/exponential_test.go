@@ -21,6 +21,9 @@ func TestExponential_CDF(t *testing.T) {
 		{"NaN case args", Exponential{0.5}, args{math.NaN()}, math.NaN()},
 		{"Normal case", Exponential{0.5}, args{2.0}, 0.632120},
 		{"Normal case 2", Exponential{0.5}, args{0.2}, 0.095162},
+		{"Negative input case", Exponential{0.5}, args{-2.0}, 0.0},
+		{"Zero input case", Exponential{0.5}, args{0.0}, 0.0},
+		{"Infinite input case", Exponential{0.5}, args{math.Inf(1)}, 1.0},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -209,3 +212,139 @@ func BenchmarkNewExponential(b *testing.B) {
 		NewExponential(1.0)
 	}
 }
+
+func TestExponential_CDFPrecision(t *testing.T) {
+	exp := Exponential{2.0}
+	want := 2e-12 - 2e-24
+	if got := exp.CDF(1e-12); math.Abs(got-want) > 1e-27 {
+		t.Errorf("CDF() = %v, want %v", got, want)
+	}
+}
+
+func TestExponential_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		exp  Exponential
+		args args
+		want float64
+	}{
+		{"NaN case", Exponential{0.5}, args{math.NaN()}, math.NaN()},
+		{"Negative input case", Exponential{0.5}, args{-1.0}, 0.0},
+		{"Zero input case", Exponential{0.5}, args{0.0}, 0.5},
+		{"Normal case", Exponential{0.5}, args{2.0}, 0.183940},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.exp.PDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("PDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponential_Survival(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		exp  Exponential
+		args args
+		want float64
+	}{
+		{"Negative input case", Exponential{0.5}, args{-2.0}, 1.0},
+		{"Normal case", Exponential{0.5}, args{2.0}, math.Exp(-1.0)},
+		{"Far tail case", Exponential{1.0}, args{700.0}, math.Exp(-700.0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exp.Survival(tt.args.x); math.Abs(got-tt.want) > 1e-12*tt.want {
+				t.Errorf("Survival() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponential_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		exp  Exponential
+		args args
+		want float64
+	}{
+		{"Invalid case", Exponential{0.5}, args{-0.1}, math.NaN()},
+		{"Lower bound case", Exponential{0.5}, args{0.0}, 0.0},
+		{"Upper bound case", Exponential{0.5}, args{1.0}, math.Inf(1)},
+		{"Median case", Exponential{0.5}, args{0.5}, 2 * math.Ln2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.exp.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponential_Hazard(t *testing.T) {
+	exp := Exponential{0.5}
+	for _, x := range []float64{0.0, 1.0, 10.0, 100.0} {
+		if got := exp.Hazard(x); got != 0.5 {
+			t.Errorf("Hazard(%v) = %v, want %v", x, got, 0.5)
+		}
+		if got := exp.PDF(x) / exp.Survival(x); math.Abs(got-exp.Hazard(x)) > 1e-12 {
+			t.Errorf("PDF(%v)/Survival(%v) = %v, want %v", x, x, got, exp.Hazard(x))
+		}
+	}
+	if got := exp.Hazard(-1.0); got != 0 {
+		t.Errorf("Hazard(-1) = %v, want 0", got)
+	}
+}
+
+func TestExponential_ConditionalSurvival(t *testing.T) {
+	type args struct {
+		s float64
+		t float64
+	}
+	tests := []struct {
+		name string
+		exp  Exponential
+		args args
+		want float64
+	}{
+		{"NaN case", Exponential{0.5}, args{math.NaN(), 1.0}, math.NaN()},
+		{"Memoryless case", Exponential{0.5}, args{3.0, 2.0}, math.Exp(-1.0)},
+		{"Zero elapsed case", Exponential{0.5}, args{0.0, 2.0}, math.Exp(-1.0)},
+		{"Long elapsed case", Exponential{1.0}, args{1e4, 1.0}, math.Exp(-1.0)},
+		{"Negative elapsed case", Exponential{0.5}, args{-2.0, 4.0}, math.Exp(-1.0)},
+		{"Negative wait case", Exponential{0.5}, args{3.0, -1.0}, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.exp.ConditionalSurvival(tt.args.s, tt.args.t)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("ConditionalSurvival() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("ConditionalSurvival() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
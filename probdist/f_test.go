@@ -0,0 +1,164 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_f_CDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		f    F
+		args args
+		want float64
+	}{
+		{"NaN case", F{math.NaN(), 2}, args{1.0}, math.NaN()},
+		{"Negative input case", F{2, 4}, args{-1.0}, 0.0},
+		{"Closed form case", F{2, 4}, args{0.1}, 1 - math.Pow(4/4.2, 2)},
+		{"Table case", F{5, 10}, args{3.325835}, 0.95},
+		{"Student case", F{1, 6}, args{4.0}, 2*StudentsT{6}.CDF(2.0) - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.CDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("CDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_f_Survival(t *testing.T) {
+	tests := []struct {
+		name string
+		f    F
+		x    float64
+		want float64
+	}{
+		{"Closed form case", F{2, 15}, 9.264706, math.Pow(15/(15+2*9.264706), 7.5)},
+		{"Far tail case", F{2, 10}, 1e4, math.Pow(10/(10+2e4), 5)},
+		{"Negative input case", F{2, 10}, -1, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Survival(tt.x); math.Abs(got-tt.want) > 1e-12*tt.want {
+				t.Errorf("Survival() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_f_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		f    F
+		args args
+		want float64
+	}{
+		{"Negative input case", F{2, 4}, args{-1.0}, 0.0},
+		{"Zero input case", F{2, 4}, args{0.0}, 1.0},
+		{"Closed form case", F{2, 4}, args{1.0}, math.Pow(1.5, -3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.PDF(tt.args.x); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_f_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		f    F
+		args args
+		want float64
+	}{
+		{"Invalid case", F{5, 10}, args{-1.0}, math.NaN()},
+		{"Lower bound case", F{5, 10}, args{0.0}, 0.0},
+		{"Upper bound case", F{5, 10}, args{1.0}, math.Inf(1)},
+		{"Table case", F{5, 10}, args{0.95}, 3.325835},
+		{"Closed form case", F{2, 4}, args{1 - math.Pow(4/4.2, 2)}, 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_f_Moments(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        F
+		mean     float64
+		variance float64
+	}{
+		{"Normal case", F{5, 10}, 1.25, 1.354167},
+		{"Infinite variance case", F{5, 4}, 2, math.Inf(1)},
+		{"Undefined case", F{5, 2}, math.NaN(), math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.Mean()
+			if math.IsNaN(got) != math.IsNaN(tt.mean) || (!math.IsNaN(got) && math.Abs(got-tt.mean) > 1e-6) {
+				t.Errorf("Mean() = %v, want %v", got, tt.mean)
+			}
+			got = tt.f.Variance()
+			if math.IsNaN(got) != math.IsNaN(tt.variance) || (!math.IsNaN(got) && got != tt.variance && math.Abs(got-tt.variance) > 1e-6) {
+				t.Errorf("Variance() = %v, want %v", got, tt.variance)
+			}
+		})
+	}
+}
+
+func TestNewF(t *testing.T) {
+	type args struct {
+		d1 float64
+		d2 float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    F
+		wantErr bool
+	}{
+		{"Normal case", args{3.0, 12.0}, F{3.0, 12.0}, false},
+		{"Invalid d1 case", args{0.0, 12.0}, F{}, true},
+		{"Invalid d2 case", args{3.0, -1.0}, F{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewF(tt.args.d1, tt.args.d2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewF() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
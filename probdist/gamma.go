@@ -23,6 +23,58 @@ func NewGamma(k float64, theta float64) (Gamma, error) {
 	return Gamma{K: k, Theta: theta}, nil
 }
 
+// PDF returns the probability density function output of the gamma distribution for a given x.
+func (g Gamma) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	if x == 0 {
+		switch {
+		case g.K < 1:
+			return math.Inf(1)
+		case g.K == 1:
+			return 1 / g.Theta
+		default:
+			return 0
+		}
+	}
+	return math.Exp(g.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the gamma distribution for a given x.
+func (g Gamma) LogPDF(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(g.PDF(0))
+	}
+	return (g.K-1)*math.Log(x) - x/g.Theta - lgamma(g.K) - g.K*math.Log(g.Theta)
+}
+
+// CDF returns the cumulative distribution function output of the gamma distribution for a given x.
+// It is computed as the regularized lower incomplete gamma function P(K, x/Theta).
+func (g Gamma) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return RegularizedGammaP(g.K, x/g.Theta)
+}
+
+// Survival returns the survival function output of the gamma distribution for a given x.
+// It is computed as the regularized upper incomplete gamma function Q(K, x/Theta).
+func (g Gamma) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return RegularizedGammaQ(g.K, x/g.Theta)
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the gamma distribution.
+func (g Gamma) Quantile(p float64) float64 {
+	return g.Theta * invRegGammaP(g.K, p)
+}
+
 // Mean returns the mean of the gamma distribution.
 func (g Gamma) Mean() float64 {
 	return g.K * g.Theta
@@ -37,3 +89,8 @@ func (g Gamma) StdDev() float64 {
 func (g Gamma) Variance() float64 {
 	return g.K * g.Theta * g.Theta
 }
+
+// Support returns the interval where the gamma distribution is defined.
+func (g Gamma) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
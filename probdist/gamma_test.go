@@ -172,3 +172,115 @@ func BenchmarkNewGamma(b *testing.B) {
 		NewGamma(2.0, 1.5)
 	}
 }
+
+func Test_gamma_CDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		g    Gamma
+		args args
+		want float64
+	}{
+		{"NaN case k", Gamma{math.NaN(), 2.0}, args{2.0}, math.NaN()},
+		{"Negative input case", Gamma{2.0, 2.0}, args{-1.0}, 0.0},
+		{"Normal case", Gamma{2.0, 2.0}, args{2.0}, 0.264241},
+		{"Normal case 2", Gamma{3.0, 1.0}, args{2.0}, 0.323324},
+		{"Exponential case", Gamma{1.0, 2.0}, args{4.0}, 0.864665},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.CDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("CDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_gamma_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		g    Gamma
+		args args
+		want float64
+	}{
+		{"Negative input case", Gamma{2.0, 2.0}, args{-1.0}, 0.0},
+		{"Zero input case", Gamma{2.0, 2.0}, args{0.0}, 0.0},
+		{"Exponential zero case", Gamma{1.0, 2.0}, args{0.0}, 0.5},
+		{"Normal case", Gamma{2.0, 2.0}, args{2.0}, 0.183940},
+		{"Normal case 2", Gamma{3.0, 1.0}, args{2.0}, 0.270671},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.PDF(tt.args.x); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_gamma_LogPDF(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Gamma
+		x    float64
+		want float64
+	}{
+		{"Negative input case", Gamma{2.0, 2.0}, -1.0, math.Inf(-1)},
+		{"Normal case", Gamma{2.0, 2.0}, 2.0, math.Log(0.18393972058572117)},
+		{"Far tail case", Gamma{2.0, 1.0}, 1000.0, math.Log(1000.0) - 1000.0},
+		{"Latency case", Gamma{9.0, 0.5}, 4.0, 8*math.Log(4.0) - 8.0 - math.Log(40320.0) - 9*math.Log(0.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.LogPDF(tt.x)
+			if math.IsInf(tt.want, -1) {
+				if !math.IsInf(got, -1) {
+					t.Errorf("LogPDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-12*math.Max(1, math.Abs(tt.want)) {
+				t.Errorf("LogPDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_gamma_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		g    Gamma
+		args args
+		want float64
+	}{
+		{"NaN case", Gamma{2.0, 2.0}, args{math.NaN()}, math.NaN()},
+		{"Lower bound case", Gamma{2.0, 2.0}, args{0.0}, 0.0},
+		{"Upper bound case", Gamma{2.0, 2.0}, args{1.0}, math.Inf(1)},
+		{"Exponential case", Gamma{1.0, 2.0}, args{0.5}, 2 * math.Ln2},
+		{"Exponential tail case", Gamma{1.0, 0.5}, args{0.999}, -0.5 * math.Log(0.001)},
+		{"Chi squared case", Gamma{1.0, 2.0}, args{0.95}, -2 * math.Log(0.05)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-12*tt.want {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package stats
+
+import "math"
+
+// Kolmogorov represents the Kolmogorov distribution, the limit distribution of sqrt(n) D
+// where D is the Kolmogorov-Smirnov statistic of a sample of size n. It has no parameter.
+type Kolmogorov struct{}
+
+// kolmogorovSwitch is the point below which the CDF is computed from its theta function series,
+// which converges faster than the alternating series for small x.
+const kolmogorovSwitch = 1.0
+
+// PDF returns the probability density function output of the Kolmogorov distribution for a given x.
+func (k Kolmogorov) PDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	sum := 0.0
+	if x < kolmogorovSwitch {
+		for i := 1; i < 100; i++ {
+			a := float64(2*i-1) * float64(2*i-1) * math.Pi * math.Pi / 8
+			term := math.Exp(-a/(x*x)) * (2*a/(x*x*x*x) - 1/(x*x))
+			sum += term
+			if math.Abs(term) < specialEpsilon*math.Abs(sum) {
+				break
+			}
+		}
+		return math.Sqrt(2*math.Pi) * sum
+	}
+	sign := 1.0
+	for i := 1; i < 100; i++ {
+		fi := float64(i)
+		term := sign * fi * fi * math.Exp(-2*fi*fi*x*x)
+		sum += term
+		if math.Abs(term) < specialEpsilon*math.Abs(sum) {
+			break
+		}
+		sign = -sign
+	}
+	return 8 * x * sum
+}
+
+// CDF returns the cumulative distribution function output of the Kolmogorov distribution for a given x.
+func (k Kolmogorov) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x < kolmogorovSwitch {
+		sum := 0.0
+		for i := 1; i < 100; i++ {
+			term := math.Exp(-float64(2*i-1) * float64(2*i-1) * math.Pi * math.Pi / (8 * x * x))
+			sum += term
+			if term < specialEpsilon*sum {
+				break
+			}
+		}
+		return math.Sqrt(2*math.Pi) / x * sum
+	}
+	return 1 - k.Survival(x)
+}
+
+// Survival returns the survival function output of the Kolmogorov distribution for a given x.
+// This is the asymptotic p-value of a Kolmogorov-Smirnov test statistic sqrt(n) D.
+func (k Kolmogorov) Survival(x float64) float64 {
+	if x < kolmogorovSwitch {
+		return 1 - k.CDF(x)
+	}
+	sum := 0.0
+	sign := 1.0
+	for i := 1; i < 100; i++ {
+		fi := float64(i)
+		term := sign * math.Exp(-2*fi*fi*x*x)
+		sum += term
+		if math.Abs(term) < specialEpsilon*math.Abs(sum) {
+			break
+		}
+		sign = -sign
+	}
+	return 2 * sum
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the Kolmogorov distribution.
+// It is found by bisection.
+func (k Kolmogorov) Quantile(p float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 0 {
+		return 0
+	}
+	if p == 1 {
+		return math.Inf(1)
+	}
+	// Survival(10) is below the smallest float64, so the quantile is always in (0, 10).
+	lo, hi := 0.0, 10.0
+	for i := 0; i < 200 && hi-lo > 1e-15*hi; i++ {
+		mid := (lo + hi) / 2
+		// The upper tail is compared through Survival to keep its precision.
+		if (p <= 0.5 && k.CDF(mid) < p) || (p > 0.5 && k.Survival(mid) > 1-p) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// Mean returns the mean of the Kolmogorov distribution, sqrt(pi/2) ln 2.
+func (k Kolmogorov) Mean() float64 {
+	return math.Sqrt(math.Pi/2) * math.Ln2
+}
+
+// StdDev returns the standard deviation of the Kolmogorov distribution.
+func (k Kolmogorov) StdDev() float64 {
+	return math.Sqrt(k.Variance())
+}
+
+// Variance returns the variance of the Kolmogorov distribution, pi²/12 - pi/2 ln² 2.
+func (k Kolmogorov) Variance() float64 {
+	return math.Pi*math.Pi/12 - math.Pi/2*math.Ln2*math.Ln2
+}
+
+// Support returns the interval where the Kolmogorov distribution is defined.
+func (k Kolmogorov) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
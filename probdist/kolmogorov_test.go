@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_kolmogorov_CDF(t *testing.T) {
+	tests := []struct {
+		name string
+		x    float64
+		want float64
+	}{
+		{"NaN case", math.NaN(), math.NaN()},
+		{"Negative input case", -1, 0},
+		{"Zero case", 0, 0},
+		{"Small case", 0.5, 0.036055},
+		{"Switch case", 1, 0.730000},
+		{"Critical value case", 1.358099, 0.95},
+		{"Tail case", 2, 0.999329},
+		{"Infinite case", math.Inf(1), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Kolmogorov{}.CDF(tt.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("CDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_kolmogorov_Survival(t *testing.T) {
+	tests := []struct {
+		name string
+		x    float64
+		want float64
+	}{
+		{"Zero case", 0, 1},
+		{"Small case", 0.5, 0.963945},
+		{"Tail case", 2, 6.709253e-4},
+		{"Far tail case", 5, 3.857499e-22},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Kolmogorov{}.Survival(tt.x)
+			if math.Abs(got-tt.want) > 1e-6*tt.want {
+				t.Errorf("Survival() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_kolmogorov_PDF(t *testing.T) {
+	const h = 1e-5
+	k := Kolmogorov{}
+	for _, x := range []float64{0.3, 0.6, 0.99, 1.01, 1.5, 2.5} {
+		want := (k.CDF(x+h) - k.CDF(x-h)) / (2 * h)
+		if got := k.PDF(x); math.Abs(got-want) > 1e-6 {
+			t.Errorf("PDF(%v) = %v, want %v", x, got, want)
+		}
+	}
+	if got := k.PDF(-1); got != 0 {
+		t.Errorf("PDF(-1) = %v, want 0", got)
+	}
+}
+
+func Test_kolmogorov_Moments(t *testing.T) {
+	k := Kolmogorov{}
+	if got := k.Mean(); math.Abs(got-0.868731) > 1e-6 {
+		t.Errorf("Mean() = %v, want 0.868731", got)
+	}
+	if got := k.StdDev(); math.Abs(got-0.260332) > 1e-6 {
+		t.Errorf("StdDev() = %v, want 0.260332", got)
+	}
+}
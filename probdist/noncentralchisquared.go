@@ -0,0 +1,84 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// NoncentralChiSquared is used to represent the noncentral chi-squared distribution parameters.
+// K is the number of degrees of freedom and must be > 0.
+// Lambda is the noncentrality parameter and must be >= 0. With Lambda = 0 it is the chi-squared distribution.
+// It is the distribution of chi-squared test statistics under an alternative hypothesis.
+type NoncentralChiSquared struct {
+	K      float64
+	Lambda float64
+}
+
+// NewNoncentralChiSquared is used to initialize noncentral chi-squared parameters. What is different from
+// NoncentralChiSquared type is that here the parameters are validated.
+// K must be a real number > 0 and Lambda a real number >= 0.
+func NewNoncentralChiSquared(k float64, lambda float64) (NoncentralChiSquared, error) {
+	if !(k > 0) || !(lambda >= 0) || math.IsInf(lambda, 1) {
+		return NoncentralChiSquared{}, errors.New("stats: invalid NoncentralChiSquared parameters. Check K > 0 and Lambda >= 0")
+	}
+	return NoncentralChiSquared{K: k, Lambda: lambda}, nil
+}
+
+// PDF returns the probability density function output of the noncentral chi-squared distribution for a given x.
+// It is computed as a Poisson mixture of chi-squared densities with K + 2j degrees of freedom.
+func (nc NoncentralChiSquared) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return poissonMixture(nc.Lambda/2, 0, func(j float64) float64 {
+		return ChiSquared{K: nc.K + 2*j}.PDF(x)
+	})
+}
+
+// CDF returns the cumulative distribution function output of the noncentral chi-squared distribution for a given x.
+// It is computed as a Poisson mixture of regularized gamma functions P(K/2 + j, x/2).
+func (nc NoncentralChiSquared) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return clampProbability(poissonMixture(nc.Lambda/2, 0, func(j float64) float64 {
+		return RegularizedGammaP(nc.K/2+j, x/2)
+	}))
+}
+
+// Survival returns the survival function output of the noncentral chi-squared distribution for a given x.
+// It is the power of a chi-squared test whose critical value is x.
+func (nc NoncentralChiSquared) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return clampProbability(poissonMixture(nc.Lambda/2, 0, func(j float64) float64 {
+		return RegularizedGammaQ(nc.K/2+j, x/2)
+	}))
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the noncentral chi-squared distribution.
+// It is found numerically.
+func (nc NoncentralChiSquared) Quantile(p float64) float64 {
+	return quantileContinuous(nc, p, nc.Mean(), nc.StdDev())
+}
+
+// Mean returns the mean of the noncentral chi-squared distribution.
+func (nc NoncentralChiSquared) Mean() float64 {
+	return nc.K + nc.Lambda
+}
+
+// StdDev returns the standard deviation of the noncentral chi-squared distribution.
+func (nc NoncentralChiSquared) StdDev() float64 {
+	return math.Sqrt(nc.Variance())
+}
+
+// Variance returns the variance of the noncentral chi-squared distribution.
+func (nc NoncentralChiSquared) Variance() float64 {
+	return 2 * (nc.K + 2*nc.Lambda)
+}
+
+// Support returns the interval where the noncentral chi-squared distribution is defined.
+func (nc NoncentralChiSquared) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_noncentralChiSquared_CDF(t *testing.T) {
+	tests := []struct {
+		name string
+		nc   NoncentralChiSquared
+		x    float64
+		want float64
+	}{
+		{"Negative input case", NoncentralChiSquared{3, 2}, -1, 0},
+		{"Central case", NoncentralChiSquared{4, 0}, 3, ChiSquared{4}.CDF(3)},
+		{"Small case", NoncentralChiSquared{3, 2}, 4, 0.4838813538},
+		{"One df case", NoncentralChiSquared{1, 0.5}, 0.2, 0.2732886611},
+		{"Upper case", NoncentralChiSquared{10, 20}, 40, 0.8433561681},
+		{"Large noncentrality case", NoncentralChiSquared{5, 100}, 60, 0.006546909809},
+		{"Huge noncentrality case", NoncentralChiSquared{3, 1e4}, 100, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.nc.CDF(tt.x)
+			if math.Abs(got-tt.want) > 1e-8 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+			s := tt.nc.Survival(tt.x)
+			if math.Abs(got+s-1) > 1e-12 {
+				t.Errorf("CDF() + Survival() = %v, want 1", got+s)
+			}
+			if got < 0 || got > 1 || s < 0 || s > 1 {
+				t.Errorf("CDF() = %v, Survival() = %v, want probabilities", got, s)
+			}
+		})
+	}
+}
+
+func Test_noncentralChiSquared_PDF(t *testing.T) {
+	const h = 1e-5
+	for _, nc := range []NoncentralChiSquared{{3, 2}, {10, 20}, {1, 0.5}} {
+		for _, x := range []float64{0.5, 2, 8, 30} {
+			want := (nc.CDF(x+h) - nc.CDF(x-h)) / (2 * h)
+			if got := nc.PDF(x); math.Abs(got-want) > 1e-6 {
+				t.Errorf("%v PDF(%v) = %v, want %v", nc, x, got, want)
+			}
+		}
+	}
+}
+
+func TestNewNoncentralChiSquared(t *testing.T) {
+	tests := []struct {
+		name      string
+		k, lambda float64
+		want      NoncentralChiSquared
+		wantErr   bool
+	}{
+		{"Normal case", 3, 2, NoncentralChiSquared{3, 2}, false},
+		{"Central case", 3, 0, NoncentralChiSquared{3, 0}, false},
+		{"Negative noncentrality case", 3, -1, NoncentralChiSquared{}, true},
+		{"Zero df case", 0, 1, NoncentralChiSquared{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNoncentralChiSquared(tt.k, tt.lambda)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNoncentralChiSquared() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNoncentralChiSquared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
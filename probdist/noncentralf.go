@@ -0,0 +1,102 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// NoncentralF is used to represent the noncentral F distribution parameters.
+// D1 and D2 are the degrees of freedom of the numerator and the denominator, both > 0.
+// Lambda is the noncentrality parameter of the numerator and must be >= 0. With Lambda = 0 it is the F distribution.
+// It is the distribution of ANOVA F statistics under an alternative hypothesis.
+type NoncentralF struct {
+	D1     float64
+	D2     float64
+	Lambda float64
+}
+
+// NewNoncentralF is used to initialize noncentral F parameters. What is different from
+// NoncentralF type is that here the parameters are validated.
+// D1 > 0, D2 > 0 and Lambda >= 0, all real numbers.
+func NewNoncentralF(d1 float64, d2 float64, lambda float64) (NoncentralF, error) {
+	if !(d1 > 0) || !(d2 > 0) || !(lambda >= 0) || math.IsInf(lambda, 1) {
+		return NoncentralF{}, errors.New("stats: invalid NoncentralF parameters. Check D1 > 0, D2 > 0 and Lambda >= 0")
+	}
+	return NoncentralF{D1: d1, D2: d2, Lambda: lambda}, nil
+}
+
+// PDF returns the probability density function output of the noncentral F distribution for a given x.
+// It is computed as a Poisson mixture of the densities of (D1+2j)/D1 F(D1+2j, D2).
+func (nf NoncentralF) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return poissonMixture(nf.Lambda/2, 0, func(j float64) float64 {
+		d := nf.D1 + 2*j
+		return nf.D1 / d * F{D1: d, D2: nf.D2}.PDF(nf.D1*x/d)
+	})
+}
+
+// CDF returns the cumulative distribution function output of the noncentral F distribution for a given x.
+// It is computed as a Poisson mixture of regularized incomplete beta functions I_{D1x/(D1x+D2)}(D1/2 + j, D2/2).
+func (nf NoncentralF) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	y := nf.D1 * x / (nf.D1*x + nf.D2)
+	return clampProbability(poissonMixture(nf.Lambda/2, 0, func(j float64) float64 {
+		return regIncBeta(nf.D1/2+j, nf.D2/2, y)
+	}))
+}
+
+// Survival returns the survival function output of the noncentral F distribution for a given x.
+// It is the power of an F test whose critical value is x.
+func (nf NoncentralF) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	y := nf.D2 / (nf.D1*x + nf.D2)
+	return clampProbability(poissonMixture(nf.Lambda/2, 0, func(j float64) float64 {
+		return regIncBeta(nf.D2/2, nf.D1/2+j, y)
+	}))
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the noncentral F distribution.
+// It is found numerically.
+func (nf NoncentralF) Quantile(p float64) float64 {
+	return quantileContinuous(nf, p, nf.Mean(), nf.StdDev())
+}
+
+// Mean returns the mean of the noncentral F distribution. It is undefined for D2 <= 2.
+func (nf NoncentralF) Mean() float64 {
+	if !(nf.D2 > 2) || math.IsNaN(nf.D1) {
+		return math.NaN()
+	}
+	return nf.D2 * (nf.D1 + nf.Lambda) / (nf.D1 * (nf.D2 - 2))
+}
+
+// StdDev returns the standard deviation of the noncentral F distribution.
+func (nf NoncentralF) StdDev() float64 {
+	return math.Sqrt(nf.Variance())
+}
+
+// Variance returns the variance of the noncentral F distribution.
+// It is infinite for 2 < D2 <= 4 and undefined for D2 <= 2.
+func (nf NoncentralF) Variance() float64 {
+	d1, d2, l := nf.D1, nf.D2, nf.Lambda
+	switch {
+	case math.IsNaN(d1) || math.IsNaN(l):
+		return math.NaN()
+	case d2 > 4:
+		return 2 * (d2 / d1) * (d2 / d1) * ((d1+l)*(d1+l) + (d1+2*l)*(d2-2)) / ((d2 - 2) * (d2 - 2) * (d2 - 4))
+	case d2 > 2:
+		return math.Inf(1)
+	default:
+		return math.NaN()
+	}
+}
+
+// Support returns the interval where the noncentral F distribution is defined.
+func (nf NoncentralF) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
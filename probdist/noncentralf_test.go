@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_noncentralF_CDF(t *testing.T) {
+	tests := []struct {
+		name string
+		nf   NoncentralF
+		x    float64
+		want float64
+	}{
+		{"Negative input case", NoncentralF{3, 20, 5}, -1, 0},
+		{"Central case", NoncentralF{4, 12, 0}, 2.5, F{4, 12}.CDF(2.5)},
+		{"Noncentral case", NoncentralF{3, 20, 5}, 2, 0.4031300843},
+		{"Two df case", NoncentralF{2, 10, 1}, 0.5, 0.26084},
+		{"Huge noncentrality case", NoncentralF{3, 20, 1e4}, 100, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.nf.CDF(tt.x)
+			if math.Abs(got-tt.want) > 1e-5 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+			s := tt.nf.Survival(tt.x)
+			if math.Abs(got+s-1) > 1e-12 {
+				t.Errorf("CDF() + Survival() = %v, want 1", got+s)
+			}
+			if got < 0 || got > 1 || s < 0 || s > 1 {
+				t.Errorf("CDF() = %v, Survival() = %v, want probabilities", got, s)
+			}
+		})
+	}
+}
+
+func Test_noncentralF_PDF(t *testing.T) {
+	const h = 1e-5
+	for _, nf := range []NoncentralF{{3, 20, 5}, {4, 45, 12}} {
+		for _, x := range []float64{0.5, 1, 2, 4} {
+			want := (nf.CDF(x+h) - nf.CDF(x-h)) / (2 * h)
+			if got := nf.PDF(x); math.Abs(got-want) > 1e-6 {
+				t.Errorf("%v PDF(%v) = %v, want %v", nf, x, got, want)
+			}
+		}
+	}
+}
+
+func Test_noncentralF_Moments(t *testing.T) {
+	nf := NoncentralF{4, 20, 3}
+	if got, want := nf.Mean(), 20.0*7/(4*18); math.Abs(got-want) > 1e-12 {
+		t.Errorf("Mean() = %v, want %v", got, want)
+	}
+	if got, want := nf.Variance(), 2*25*(49+10*18.0)/(18*18*16); math.Abs(got-want) > 1e-12 {
+		t.Errorf("Variance() = %v, want %v", got, want)
+	}
+	if got := (NoncentralF{4, 3, 1}).Variance(); !math.IsInf(got, 1) {
+		t.Errorf("Variance() = %v, want +Inf", got)
+	}
+	if got := (NoncentralF{4, 2, 1}).Mean(); !math.IsNaN(got) {
+		t.Errorf("Mean() = %v, want NaN", got)
+	}
+}
+
+func TestNewNoncentralF(t *testing.T) {
+	tests := []struct {
+		name           string
+		d1, d2, lambda float64
+		want           NoncentralF
+		wantErr        bool
+	}{
+		{"Normal case", 3, 20, 5, NoncentralF{3, 20, 5}, false},
+		{"Negative noncentrality case", 3, 20, -5, NoncentralF{}, true},
+		{"Zero df case", 3, 0, 5, NoncentralF{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNoncentralF(tt.d1, tt.d2, tt.lambda)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNoncentralF() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNoncentralF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
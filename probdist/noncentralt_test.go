@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// The reference values of the noncentral distributions were computed by numerical integration of their definitions.
+
+func Test_noncentralT_CDF(t *testing.T) {
+	tests := []struct {
+		name string
+		nt   NoncentralT
+		x    float64
+		want float64
+	}{
+		{"NaN case", NoncentralT{math.NaN(), 1}, 1, math.NaN()},
+		{"Central case", NoncentralT{7, 0}, 1.3, StudentsT{7}.CDF(1.3)},
+		{"Zero case", NoncentralT{5, 1.2}, 0, StandardNormal().CDF(-1.2)},
+		{"Positive case", NoncentralT{10, 1}, 2, 0.8076115625},
+		{"Negative noncentrality case", NoncentralT{5, -1.5}, 0.3, 0.9622986873},
+		{"Negative input case", NoncentralT{20, 3}, -1, 4.061502127e-05},
+		{"Fractional df case", NoncentralT{3.5, 0.5}, 1.2, 0.7135453019},
+		{"Large noncentrality case", NoncentralT{12, 40}, 38, 0.3491930978},
+		{"Infinite case", NoncentralT{12, 4}, math.Inf(1), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.nt.CDF(tt.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("CDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_noncentralT_PDF(t *testing.T) {
+	const h = 1e-5
+	for _, nt := range []NoncentralT{{10, 1}, {5, -1.5}, {3.5, 0.5}} {
+		for _, x := range []float64{-1, 0.3, 1.2, 2} {
+			want := (nt.CDF(x+h) - nt.CDF(x-h)) / (2 * h)
+			if got := nt.PDF(x); math.Abs(got-want) > 1e-6 {
+				t.Errorf("%v PDF(%v) = %v, want %v", nt, x, got, want)
+			}
+		}
+	}
+	if got, want := (NoncentralT{7, 0}).PDF(0), (StudentsT{7}).PDF(0); math.Abs(got-want) > 1e-12 {
+		t.Errorf("PDF(0) = %v, want %v", got, want)
+	}
+}
+
+func Test_noncentralT_Moments(t *testing.T) {
+	tests := []struct {
+		name     string
+		nt       NoncentralT
+		mean     float64
+		variance float64
+	}{
+		{"Central case", NoncentralT{5, 0}, 0, 5.0 / 3},
+		{"Noncentral case", NoncentralT{10, 2}, 2.167445, 1.552184},
+		{"Infinite variance case", NoncentralT{2, 1}, 1.772454, math.Inf(1)},
+		{"Undefined case", NoncentralT{1, 1}, math.NaN(), math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.nt.Mean(); !closeOrSame(got, tt.mean, 1e-6) {
+				t.Errorf("Mean() = %v, want %v", got, tt.mean)
+			}
+			if got := tt.nt.Variance(); !closeOrSame(got, tt.variance, 1e-6) {
+				t.Errorf("Variance() = %v, want %v", got, tt.variance)
+			}
+		})
+	}
+}
+
+// closeOrSame reports whether got and want are within tol, or both NaN, or the same infinity.
+func closeOrSame(got float64, want float64, tol float64) bool {
+	if math.IsNaN(got) || math.IsNaN(want) {
+		return math.IsNaN(got) && math.IsNaN(want)
+	}
+	if math.IsInf(want, 0) {
+		return got == want
+	}
+	return math.Abs(got-want) <= tol
+}
+
+func TestNewNoncentralT(t *testing.T) {
+	tests := []struct {
+		name    string
+		v, mu   float64
+		want    NoncentralT
+		wantErr bool
+	}{
+		{"Normal case", 10, -1.5, NoncentralT{10, -1.5}, false},
+		{"Negative df case", -1, 1, NoncentralT{}, true},
+		{"NaN noncentrality case", 5, math.NaN(), NoncentralT{}, true},
+		{"Infinite noncentrality case", 5, math.Inf(1), NoncentralT{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNoncentralT(tt.v, tt.mu)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNoncentralT() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNoncentralT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -23,14 +23,124 @@ func NewNormal(mu float64, sigma float64) (Normal, error) {
 	return Normal{Mu: mu, Sigma: sigma}, nil
 }
 
+// StandardNormal returns the standard normal distribution N(0,1).
+func StandardNormal() Normal {
+	return Normal{Mu: 0, Sigma: 1}
+}
+
+// GetZStatistic returns the critical value z such that P(Z > z) = alpha for the standard normal distribution.
+func GetZStatistic(alpha float64) float64 {
+	if alpha <= 0 || alpha >= 1 {
+		return math.NaN()
+	}
+	return -StandardNormal().Quantile(alpha)
+}
+
 // PDF returns the probability density function output of the normal distribution for a given x.
 func (norm Normal) PDF(x float64) float64 {
 	return math.Exp(-(x-norm.Mu)*(x-norm.Mu)/(2*norm.Sigma*norm.Sigma)) / (norm.Sigma * math.Sqrt(2*math.Pi))
 }
 
+// LogPDF returns the natural logarithm of the probability density function of the normal distribution for a given x.
+func (norm Normal) LogPDF(x float64) float64 {
+	z := (x - norm.Mu) / norm.Sigma
+	return -z*z/2 - math.Log(norm.Sigma) - 0.5*math.Log(2*math.Pi)
+}
+
 // CDF returns the cumulative distribution function output of the normal distribution for a given x.
+// It is computed through Erfc so that the left tail keeps its relative precision.
 func (norm Normal) CDF(x float64) float64 {
-	return 0.5 * (1 + math.Erf((x-norm.Mu)/(math.Sqrt(2)*norm.Sigma)))
+	return 0.5 * math.Erfc(-(x-norm.Mu)/(math.Sqrt(2)*norm.Sigma))
+}
+
+// LogCDF returns the natural logarithm of the cumulative distribution function of the normal distribution.
+// It stays finite and accurate far in the left tail, where CDF underflows to 0.
+func (norm Normal) LogCDF(x float64) float64 {
+	z := (x - norm.Mu) / norm.Sigma
+	switch {
+	case math.IsNaN(z):
+		return math.NaN()
+	case z < -35:
+		// Asymptotic expansion of the Mills ratio.
+		z2 := 1 / (z * z)
+		series := 1 - z2*(1-z2*(3-z2*(15-z2*(105-z2*945))))
+		return -z*z/2 - math.Log(-z) - 0.5*math.Log(2*math.Pi) + math.Log(series)
+	case z > 0:
+		return math.Log1p(-norm.Survival(x))
+	default:
+		return math.Log(norm.CDF(x))
+	}
+}
+
+// Survival returns the survival function output of the normal distribution for a given x.
+func (norm Normal) Survival(x float64) float64 {
+	return 0.5 * math.Erfc((x-norm.Mu)/(math.Sqrt(2)*norm.Sigma))
+}
+
+// Quantile returns the inverse of the cumulative distribution function of the normal distribution.
+// It uses Wichura's algorithm AS241 (PPND16), which is accurate to about 1e-16.
+func (norm Normal) Quantile(p float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	return norm.Mu + norm.Sigma*ppnd16(p)
+}
+
+// ppnd16 returns the standard normal quantile of p following Wichura, M. J. (1988).
+// Algorithm AS 241: The percentage points of the normal distribution. Applied Statistics, 37, 477-484.
+func ppnd16(p float64) float64 {
+	if p == 0 {
+		return math.Inf(-1)
+	}
+	if p == 1 {
+		return math.Inf(1)
+	}
+	q := p - 0.5
+	if math.Abs(q) <= 0.425 {
+		r := 0.180625 - q*q
+		return q * (((((((r*2509.0809287301226727+
+			33430.575583588128105)*r+67265.770927008700853)*r+
+			45921.953931549871457)*r+13731.693765509461125)*r+
+			1971.5909503065514427)*r+133.14166789178437745)*r +
+			3.387132872796366608) /
+			(((((((r*5226.495278852545925+
+				28729.085735721942674)*r+39307.89580009271061)*r+
+				21213.794301586595867)*r+5394.1960214247511077)*r+
+				687.1870074920579083)*r+42.313330701600911252)*r + 1)
+	}
+	r := p
+	if q > 0 {
+		r = 1 - p
+	}
+	r = math.Sqrt(-math.Log(r))
+	var val float64
+	if r <= 5 {
+		r -= 1.6
+		val = (((((((r*7.7454501427834140764e-4+
+			0.0227238449892691845833)*r+0.24178072517745061177)*r+
+			1.27045825245236838258)*r+3.64784832476320460504)*r+
+			5.7694972214606914055)*r+4.6303378461565452959)*r +
+			1.42343711074968357734) /
+			(((((((r*1.05075007164441684324e-9+5.475938084995344946e-4)*r+
+				0.0151986665636164571966)*r+0.14810397642748007459)*r+
+				0.68976733498510000455)*r+1.6763848301838038494)*r+
+				2.05319162663775882187)*r + 1)
+	} else {
+		r -= 5
+		val = (((((((r*2.01033439929228813265e-7+
+			2.71155556874348757815e-5)*r+0.0012426609473880784386)*r+
+			0.026532189526576123093)*r+0.29656057182850489123)*r+
+			1.7848265399172913358)*r+5.4637849111641143699)*r +
+			6.6579046435011037772) /
+			(((((((r*2.04426310338993978564e-15+1.4215117583164458887e-7)*r+
+				1.8463183175100546818e-5)*r+7.868691311456132591e-4)*r+
+				0.0148753612908506148525)*r+0.13692988092273580531)*r+
+				0.59983220655588793769)*r + 1)
+	}
+	if q < 0 {
+		val = -val
+	}
+	return val
 }
 
 // Mean returns the mean of the normal distribution.
@@ -53,95 +163,7 @@ func (norm Normal) Variance() float64 {
 	return math.Pow(norm.Sigma, 2.0)
 }
 
-//Data from: https://www.itl.nist.gov/div898/handbook/eda/section3/eda3671.htm.
-var zStandardNormal statsTable = statsTable{
-	[][]float64{
-		[]float64{0.00000, 0.00399, 0.00798, 0.01197, 0.01595, 0.01994, 0.02392, 0.02790, 0.03188, 0.03586},
-		[]float64{0.03983, 0.04380, 0.04776, 0.05172, 0.05567, 0.05962, 0.06356, 0.06749, 0.07142, 0.07535},
-		[]float64{0.07926, 0.08317, 0.08706, 0.09095, 0.09483, 0.09871, 0.10257, 0.10642, 0.11026, 0.11409},
-		[]float64{0.11791, 0.12172, 0.12552, 0.12930, 0.13307, 0.13683, 0.14058, 0.14431, 0.14803, 0.15173},
-		[]float64{0.15542, 0.15910, 0.16276, 0.16640, 0.17003, 0.17364, 0.17724, 0.18082, 0.18439, 0.18793},
-		[]float64{0.19146, 0.19497, 0.19847, 0.20194, 0.20540, 0.20884, 0.21226, 0.21566, 0.21904, 0.22240},
-		[]float64{0.22575, 0.22907, 0.23237, 0.23565, 0.23891, 0.24215, 0.24537, 0.24857, 0.25175, 0.25490},
-		[]float64{0.25804, 0.26115, 0.26424, 0.26730, 0.27035, 0.27337, 0.27637, 0.27935, 0.28230, 0.28524},
-		[]float64{0.28814, 0.29103, 0.29389, 0.29673, 0.29955, 0.30234, 0.30511, 0.30785, 0.31057, 0.31327},
-		[]float64{0.31594, 0.31859, 0.32121, 0.32381, 0.32639, 0.32894, 0.33147, 0.33398, 0.33646, 0.33891},
-		[]float64{0.34134, 0.34375, 0.34614, 0.34849, 0.35083, 0.35314, 0.35543, 0.35769, 0.35993, 0.36214},
-		[]float64{0.36433, 0.36650, 0.36864, 0.37076, 0.37286, 0.37493, 0.37698, 0.37900, 0.38100, 0.38298},
-		[]float64{0.38493, 0.38686, 0.38877, 0.39065, 0.39251, 0.39435, 0.39617, 0.39796, 0.39973, 0.40147},
-		[]float64{0.40320, 0.40490, 0.40658, 0.40824, 0.40988, 0.41149, 0.41308, 0.41466, 0.41621, 0.41774},
-		[]float64{0.41924, 0.42073, 0.42220, 0.42364, 0.42507, 0.42647, 0.42785, 0.42922, 0.43056, 0.43189},
-		[]float64{0.43319, 0.43448, 0.43574, 0.43699, 0.43822, 0.43943, 0.44062, 0.44179, 0.44295, 0.44408},
-		[]float64{0.44520, 0.44630, 0.44738, 0.44845, 0.44950, 0.45053, 0.45154, 0.45254, 0.45352, 0.45449},
-		[]float64{0.45543, 0.45637, 0.45728, 0.45818, 0.45907, 0.45994, 0.46080, 0.46164, 0.46246, 0.46327},
-		[]float64{0.46407, 0.46485, 0.46562, 0.46638, 0.46712, 0.46784, 0.46856, 0.46926, 0.46995, 0.47062},
-		[]float64{0.47128, 0.47193, 0.47257, 0.47320, 0.47381, 0.47441, 0.47500, 0.47558, 0.47615, 0.47670},
-		[]float64{0.47725, 0.47778, 0.47831, 0.47882, 0.47932, 0.47982, 0.48030, 0.48077, 0.48124, 0.48169},
-		[]float64{0.48214, 0.48257, 0.48300, 0.48341, 0.48382, 0.48422, 0.48461, 0.48500, 0.48537, 0.48574},
-		[]float64{0.48610, 0.48645, 0.48679, 0.48713, 0.48745, 0.48778, 0.48809, 0.48840, 0.48870, 0.48899},
-		[]float64{0.48928, 0.48956, 0.48983, 0.49010, 0.49036, 0.49061, 0.49086, 0.49111, 0.49134, 0.49158},
-		[]float64{0.49180, 0.49202, 0.49224, 0.49245, 0.49266, 0.49286, 0.49305, 0.49324, 0.49343, 0.49361},
-		[]float64{0.49379, 0.49396, 0.49413, 0.49430, 0.49446, 0.49461, 0.49477, 0.49492, 0.49506, 0.49520},
-		[]float64{0.49534, 0.49547, 0.49560, 0.49573, 0.49585, 0.49598, 0.49609, 0.49621, 0.49632, 0.49643},
-		[]float64{0.49653, 0.49664, 0.49674, 0.49683, 0.49693, 0.49702, 0.49711, 0.49720, 0.49728, 0.49736},
-		[]float64{0.49744, 0.49752, 0.49760, 0.49767, 0.49774, 0.49781, 0.49788, 0.49795, 0.49801, 0.49807},
-		[]float64{0.49813, 0.49819, 0.49825, 0.49831, 0.49836, 0.49841, 0.49846, 0.49851, 0.49856, 0.49861},
-		[]float64{0.49865, 0.49869, 0.49874, 0.49878, 0.49882, 0.49886, 0.49889, 0.49893, 0.49896, 0.49900},
-		[]float64{0.49903, 0.49906, 0.49910, 0.49913, 0.49916, 0.49918, 0.49921, 0.49924, 0.49926, 0.49929},
-		[]float64{0.49931, 0.49934, 0.49936, 0.49938, 0.49940, 0.49942, 0.49944, 0.49946, 0.49948, 0.49950},
-		[]float64{0.49952, 0.49953, 0.49955, 0.49957, 0.49958, 0.49960, 0.49961, 0.49962, 0.49964, 0.49965},
-		[]float64{0.49966, 0.49968, 0.49969, 0.49970, 0.49971, 0.49972, 0.49973, 0.49974, 0.49975, 0.49976},
-		[]float64{0.49977, 0.49978, 0.49978, 0.49979, 0.49980, 0.49981, 0.49981, 0.49982, 0.49983, 0.49983},
-		[]float64{0.49984, 0.49985, 0.49985, 0.49986, 0.49986, 0.49987, 0.49987, 0.49988, 0.49988, 0.49989},
-		[]float64{0.49989, 0.49990, 0.49990, 0.49990, 0.49991, 0.49991, 0.49992, 0.49992, 0.49992, 0.49992},
-		[]float64{0.49993, 0.49993, 0.49993, 0.49994, 0.49994, 0.49994, 0.49994, 0.49995, 0.49995, 0.49995},
-		[]float64{0.49995, 0.49995, 0.49996, 0.49996, 0.49996, 0.49996, 0.49996, 0.49996, 0.49997, 0.49997},
-		[]float64{0.49997, 0.49997, 0.49997, 0.49997, 0.49997, 0.49997, 0.49998, 0.49998, 0.49998, 0.49998},
-	},
-
-	[]float64{
-		0.0,
-		0.1,
-		0.2,
-		0.3,
-		0.4,
-		0.5,
-		0.6,
-		0.7,
-		0.8,
-		0.9,
-		1.0,
-		1.1,
-		1.2,
-		1.3,
-		1.4,
-		1.5,
-		1.6,
-		1.7,
-		1.8,
-		1.9,
-		2.0,
-		2.1,
-		2.2,
-		2.3,
-		2.4,
-		2.5,
-		2.6,
-		2.7,
-		2.8,
-		2.9,
-		3.0,
-		3.1,
-		3.2,
-		3.3,
-		3.4,
-		3.5,
-		3.6,
-		3.7,
-		3.8,
-		3.9,
-		4.0,
-	},
-
-	[]float64{0.00, 0.01, 0.02, 0.03, 0.04, 0.05, 0.06, 0.07, 0.08, 0.09},
+// Support returns the interval where the normal distribution is defined.
+func (norm Normal) Support() Interval {
+	return Interval{math.Inf(-1), math.Inf(1)}
 }
@@ -263,3 +263,132 @@ func BenchmarkNewNormal(b *testing.B) {
 		NewNormal(0.0, 1.0)
 	}
 }
+
+func Test_normal_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		norm Normal
+		args args
+		want float64
+	}{
+		{"NaN case", Normal{0.0, 1.0}, args{math.NaN()}, math.NaN()},
+		{"Invalid case", Normal{0.0, 1.0}, args{1.2}, math.NaN()},
+		{"Median case", Normal{0.0, 1.0}, args{0.5}, 0.0},
+		{"Central case", Normal{0.0, 1.0}, args{0.975}, 1.959963984540054},
+		{"Central left case", Normal{0.0, 1.0}, args{0.05}, -1.6448536269514722},
+		{"Intermediate tail case", Normal{0.0, 1.0}, args{1e-10}, -6.361340902404056},
+		{"Far tail case", Normal{0.0, 1.0}, args{1e-300}, -37.0470962993612},
+		{"Lower bound case", Normal{0.0, 1.0}, args{0.0}, math.Inf(-1)},
+		{"Upper bound case", Normal{0.0, 1.0}, args{1.0}, math.Inf(1)},
+		{"Shifted case", Normal{10.0, 2.0}, args{0.975}, 13.919927969080108},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.norm.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-14*math.Abs(tt.want) {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_normal_QuantileTails(t *testing.T) {
+	norm := Normal{0.0, 1.0}
+	for _, x := range []float64{-37.5, -30, -20, -8.5, -5, -3, -1, -0.1} {
+		p := norm.CDF(x)
+		if got := norm.Quantile(p); math.Abs(got-x) > 1e-13*math.Abs(x) {
+			t.Errorf("Quantile(CDF(%v)) = %v", x, got)
+		}
+		q := norm.Survival(-x)
+		if got := norm.Quantile(1 - q); q > 1e-15 && math.Abs(got+x) > 1e-8*math.Abs(x) {
+			t.Errorf("Quantile(1 - Survival(%v)) = %v", -x, got)
+		}
+	}
+}
+
+func Test_normal_LogCDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		norm Normal
+		args args
+		want float64
+	}{
+		{"NaN case", Normal{0.0, 1.0}, args{math.NaN()}, math.NaN()},
+		{"Median case", Normal{0.0, 1.0}, args{0.0}, math.Log(0.5)},
+		{"Right tail case", Normal{0.0, 1.0}, args{10.0}, -7.619853024160527e-24},
+		{"Left tail case", Normal{0.0, 1.0}, args{-10.0}, -53.23128515051247},
+		{"Expansion boundary case", Normal{0.0, 1.0}, args{-35.0}, math.Log(Normal{0.0, 1.0}.CDF(-35.0))},
+		{"Underflow case", Normal{0.0, 1.0}, args{-40.0}, -804.6084420137538},
+		{"Shifted case", Normal{5.0, 0.5}, args{-15.0}, -804.6084420137538},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.norm.LogCDF(tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("LogCDF() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-12*math.Abs(tt.want) {
+				t.Errorf("LogCDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_normal_LogPDF(t *testing.T) {
+	tests := []struct {
+		name string
+		norm Normal
+		x    float64
+	}{
+		{"Center case", Normal{0.0, 1.0}, 0.0},
+		{"Tail case", Normal{0.0, 1.0}, 5.0},
+		{"Shifted case", Normal{3.0, 0.2}, 2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := math.Log(tt.norm.PDF(tt.x))
+			if got := tt.norm.LogPDF(tt.x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("LogPDF() = %v, want %v", got, want)
+			}
+		})
+	}
+	if got := (Normal{0.0, 1.0}).LogPDF(100.0); math.Abs(got+5000.918938533205) > 1e-9 {
+		t.Errorf("LogPDF() = %v, want %v", got, -5000.918938533205)
+	}
+}
+
+func TestGetZStatistic(t *testing.T) {
+	tests := []struct {
+		name  string
+		alpha float64
+		want  float64
+	}{
+		{"One tail case", 0.05, 1.6448536269514722},
+		{"Two tails case", 0.025, 1.959963984540054},
+		{"Alert case", 0.001, 3.090232306167813},
+		{"Invalid case", 0.0, math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetZStatistic(tt.alpha)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("GetZStatistic() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-14 {
+				t.Errorf("GetZStatistic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,7 +11,7 @@ type Poisson struct {
 	Lambda float64
 }
 
-// NewPoisson is used to initialize exponential parameters. What is different from
+// NewPoisson is used to initialize poisson parameters. What is different from
 // Poisson type is that here the parameters are validated.
 // Lambda must be a real number > 0.
 func NewPoisson(lambda float64) (Poisson, error) {
@@ -21,6 +21,52 @@ func NewPoisson(lambda float64) (Poisson, error) {
 	return Poisson{Lambda: lambda}, nil
 }
 
+// PMF returns the probability of observing exactly k events in an interval.
+func (p Poisson) PMF(k float64) float64 {
+	return math.Exp(p.LogPMF(k))
+}
+
+// LogPMF returns the natural logarithm of the probability of observing exactly k events in an interval.
+func (p Poisson) LogPMF(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(p.Lambda) {
+		return math.NaN()
+	}
+	if k < 0 || math.Mod(k, 1.0) != 0 {
+		return math.Inf(-1)
+	}
+	return k*math.Log(p.Lambda) - p.Lambda - lgamma(k+1)
+}
+
+// CDF returns the probability of observing at most k events in an interval.
+// It is computed as the regularized upper incomplete gamma function Q(k+1, Lambda).
+func (p Poisson) CDF(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(p.Lambda) {
+		return math.NaN()
+	}
+	if k < 0 {
+		return 0
+	}
+	return RegularizedGammaQ(math.Floor(k)+1, p.Lambda)
+}
+
+// Survival returns the probability of observing more than k events in an interval.
+// It is computed as the regularized lower incomplete gamma function P(k+1, Lambda).
+func (p Poisson) Survival(k float64) float64 {
+	if math.IsNaN(k) || math.IsNaN(p.Lambda) {
+		return math.NaN()
+	}
+	if k < 0 {
+		return 1
+	}
+	return RegularizedGammaP(math.Floor(k)+1, p.Lambda)
+}
+
+// Quantile returns the smallest number of events k such that CDF(k) >= prob.
+func (p Poisson) Quantile(prob float64) float64 {
+	start := math.Floor(p.Mean() + p.StdDev()*StandardNormal().Quantile(prob))
+	return quantileDiscrete(p.CDF, prob, p.Support(), start)
+}
+
 // Mean returns the mean of the poisson distribution.
 func (p Poisson) Mean() float64 {
 	return p.Lambda
@@ -35,3 +81,8 @@ func (p Poisson) StdDev() float64 {
 func (p Poisson) Variance() float64 {
 	return p.Lambda
 }
+
+// Support returns the interval where the poisson distribution is defined.
+func (p Poisson) Support() Interval {
+	return Interval{0, math.Inf(1)}
+}
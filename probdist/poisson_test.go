@@ -163,3 +163,115 @@ func BenchmarkNewPoisson(b *testing.B) {
 		NewPoisson(1.0)
 	}
 }
+
+func Test_poisson_PMF(t *testing.T) {
+	type args struct {
+		k float64
+	}
+	tests := []struct {
+		name string
+		p    Poisson
+		args args
+		want float64
+	}{
+		{"Normal case", Poisson{3.0}, args{2}, 0.224042},
+		{"Zero case", Poisson{3.0}, args{0}, 0.049787},
+		{"Negative case", Poisson{3.0}, args{-1}, 0.0},
+		{"Non integer case", Poisson{3.0}, args{1.5}, 0.0},
+		{"Large k case", Poisson{500.0}, args{500}, 0.017838},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.PMF(tt.args.k); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PMF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_poisson_CDF(t *testing.T) {
+	type args struct {
+		k float64
+	}
+	tests := []struct {
+		name string
+		p    Poisson
+		args args
+		want float64
+	}{
+		{"Normal case", Poisson{3.0}, args{2}, 0.423190},
+		{"Non integer case", Poisson{3.0}, args{2.7}, 0.423190},
+		{"Negative case", Poisson{3.0}, args{-1}, 0.0},
+		{"Large lambda case", Poisson{1e6}, args{1e6}, 0.500266},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.CDF(tt.args.k); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_poisson_Survival(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Poisson
+		k    float64
+	}{
+		{"Incident case", Poisson{2.0}, 9},
+		{"Large lambda case", Poisson{1000.0}, 1100},
+		{"Lower tail case", Poisson{50.0}, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := 0.0
+			for j := tt.p.Lambda*3 + 100; j > tt.k; j-- {
+				want += tt.p.PMF(j)
+			}
+			if got := tt.p.Survival(tt.k); math.Abs(got-want) > 1e-10*want {
+				t.Errorf("Survival() = %v, want %v", got, want)
+			}
+			if got := tt.p.CDF(tt.k) + tt.p.Survival(tt.k); math.Abs(got-1) > 1e-12 {
+				t.Errorf("CDF() + Survival() = %v, want 1", got)
+			}
+		})
+	}
+}
+
+func Test_poisson_Quantile(t *testing.T) {
+	type args struct {
+		prob float64
+	}
+	tests := []struct {
+		name string
+		p    Poisson
+		args args
+		want float64
+	}{
+		{"NaN case", Poisson{3.0}, args{math.NaN()}, math.NaN()},
+		{"Median case", Poisson{3.0}, args{0.5}, 3},
+		{"Lower bound case", Poisson{3.0}, args{0.0}, 0},
+		{"Upper bound case", Poisson{3.0}, args{1.0}, math.Inf(1)},
+		{"Small lambda case", Poisson{0.1}, args{0.95}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.Quantile(tt.args.prob)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	p := Poisson{1e4}
+	for _, prob := range []float64{1e-6, 0.025, 0.5, 0.975, 1 - 1e-6} {
+		k := p.Quantile(prob)
+		if p.CDF(k) < prob || p.CDF(k-1) >= prob {
+			t.Errorf("Quantile(%v) = %v is not the smallest k with CDF(k) >= p", prob, k)
+		}
+	}
+}
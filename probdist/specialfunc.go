@@ -0,0 +1,363 @@
+package stats
+
+import "math"
+
+const (
+	specialEpsilon = 1e-15
+	specialTiny    = 1e-300
+	specialMaxIter = 10000
+)
+
+// lgamma returns the natural logarithm of the absolute value of the gamma function.
+func lgamma(x float64) float64 {
+	lg, _ := math.Lgamma(x)
+	return lg
+}
+
+// lbeta returns the natural logarithm of the beta function B(a, b) = Gamma(a) Gamma(b) / Gamma(a+b), for a, b > 0.
+// When an argument is large the differences of log-gammas are replaced by Stirling's series, as in R's lbeta,
+// since they would cancel each other and lose every significant digit.
+func lbeta(a float64, b float64) float64 {
+	p, q := math.Min(a, b), math.Max(a, b)
+	switch {
+	case p >= 10:
+		corr := lgammaCorrection(p) + lgammaCorrection(q) - lgammaCorrection(p+q)
+		return -0.5*math.Log(q) + 0.5*math.Log(2*math.Pi) + corr + (p-0.5)*math.Log(p/(p+q)) + q*math.Log1p(-p/(p+q))
+	case q >= 10:
+		corr := lgammaCorrection(q) - lgammaCorrection(p+q)
+		return lgamma(p) + corr + p - p*math.Log(p+q) + (q-0.5)*math.Log1p(-p/(p+q))
+	}
+	return lgamma(p) + lgamma(q) - lgamma(p+q)
+}
+
+// lgammaCorrection returns the remainder of Stirling's formula,
+// log Gamma(x) - (x - 1/2) log(x) + x - log(2 pi) / 2, by its asymptotic series, accurate for x >= 10.
+func lgammaCorrection(x float64) float64 {
+	// The coefficients are B(2k) / (2k (2k-1)) for the Bernoulli numbers B(2k).
+	coefficients := [...]float64{1.0 / 12, -1.0 / 360, 1.0 / 1260, -1.0 / 1680, 1.0 / 1188, -691.0 / 360360, 1.0 / 156}
+	x2 := 1 / (x * x)
+	sum := 0.0
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		sum = sum*x2 + coefficients[i]
+	}
+	return sum / x
+}
+
+// RegularizedGammaP returns the regularized lower incomplete gamma function
+// P(a, x) = 1/Gamma(a) * integral from 0 to x of t^(a-1) e^(-t) dt, for a > 0 and x >= 0.
+// It is the CDF of a gamma distribution with shape a and unit scale.
+func RegularizedGammaP(a float64, x float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(x) || a <= 0 || x < 0 {
+		return math.NaN()
+	}
+	if x == 0 {
+		return 0
+	}
+	if math.IsInf(x, 1) {
+		return 1
+	}
+	if x < a+1 {
+		return gammaSeries(a, x)
+	}
+	return 1 - gammaContinuedFraction(a, x)
+}
+
+// RegularizedGammaQ returns the regularized upper incomplete gamma function Q(a, x) = 1 - P(a, x), for a > 0 and x >= 0.
+// It is computed directly and not as 1 - P(a, x), so it keeps its precision when Q is small.
+func RegularizedGammaQ(a float64, x float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(x) || a <= 0 || x < 0 {
+		return math.NaN()
+	}
+	if x == 0 {
+		return 1
+	}
+	if math.IsInf(x, 1) {
+		return 0
+	}
+	if x < a+1 {
+		return 1 - gammaSeries(a, x)
+	}
+	return gammaContinuedFraction(a, x)
+}
+
+// gammaSeries evaluates P(a, x) by its series representation, which converges fast for x < a+1.
+func gammaSeries(a float64, x float64) float64 {
+	ap := a
+	del := 1 / a
+	sum := del
+	for i := 0; i < specialMaxIter; i++ {
+		ap++
+		del *= x / ap
+		sum += del
+		if math.Abs(del) < math.Abs(sum)*specialEpsilon {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lgamma(a))
+}
+
+// gammaContinuedFraction evaluates Q(a, x) by the modified Lentz's method, which converges fast for x >= a+1.
+func gammaContinuedFraction(a float64, x float64) float64 {
+	b := x + 1 - a
+	c := 1 / specialTiny
+	d := 1 / b
+	h := d
+	for i := 1; i < specialMaxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < specialTiny {
+			d = specialTiny
+		}
+		c = b + an/c
+		if math.Abs(c) < specialTiny {
+			c = specialTiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < specialEpsilon {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma(a)) * h
+}
+
+// invRegGammaP returns the x such that P(a, x) = p.
+// It starts from the approximation given in Numerical Recipes and refines it with Halley's method.
+func invRegGammaP(a float64, p float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(p) || a <= 0 || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 0 {
+		return 0
+	}
+	if p == 1 {
+		return math.Inf(1)
+	}
+	var x float64
+	a1 := a - 1
+	gln := lgamma(a)
+	lna1 := math.Log(a1)
+	afac := math.Exp(a1*(lna1-1) - gln)
+	if a > 1 {
+		pp := p
+		if p >= 0.5 {
+			pp = 1 - p
+		}
+		t := math.Sqrt(-2 * math.Log(pp))
+		x = (2.30753+t*0.27061)/(1+t*(0.99229+t*0.04481)) - t
+		if p < 0.5 {
+			x = -x
+		}
+		x = math.Max(1e-3, a*math.Pow(1-1/(9*a)-x/(3*math.Sqrt(a)), 3))
+	} else {
+		t := 1 - a*(0.253+a*0.12)
+		if p < t {
+			x = math.Pow(p/t, 1/a)
+		} else {
+			x = 1 - math.Log(1-(p-t)/(1-t))
+		}
+	}
+	for i := 0; i < 100; i++ {
+		if x <= 0 {
+			return 0
+		}
+		err := RegularizedGammaP(a, x) - p
+		var t float64
+		if a > 1 {
+			t = afac * math.Exp(-(x-a1)+a1*(math.Log(x)-lna1))
+		} else {
+			t = math.Exp(-x + a1*math.Log(x) - gln)
+		}
+		u := err / t
+		t = u / (1 - 0.5*math.Min(1, u*((a-1)/x-1)))
+		x -= t
+		if x <= 0 {
+			x = 0.5 * (x + t)
+		}
+		if math.Abs(t) < 1e-14*x {
+			break
+		}
+	}
+	return x
+}
+
+// regIncBeta returns the regularized incomplete beta function I_x(a, b).
+func regIncBeta(a float64, b float64, x float64) float64 {
+	return regIncBetaComplement(a, b, x, 1-x)
+}
+
+// regIncBetaComplement returns the regularized incomplete beta function I_x(a, b), given y = 1 - x as well.
+// The caller computes y apart when x is close to 1, where 1 - x would round away the digits of the result.
+func regIncBetaComplement(a float64, b float64, x float64, y float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(x) || math.IsNaN(y) || a <= 0 || b <= 0 {
+		return math.NaN()
+	}
+	if x <= 0 {
+		return 0
+	}
+	if y <= 0 {
+		return 1
+	}
+	// Each logarithm is taken of the smaller of x and y, the other one with log1p.
+	lx, ly := math.Log(x), math.Log1p(-x)
+	if x > 0.5 {
+		lx, ly = math.Log1p(-y), math.Log(y)
+	}
+	lbt := a*lx + b*ly - lbeta(a, b)
+	if x < (a+1)/(a+b+2) {
+		return math.Exp(lbt) * betaContinuedFraction(a, b, x, y) / a
+	}
+	return 1 - math.Exp(lbt)*betaContinuedFraction(b, a, y, x)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction of the incomplete beta function by the modified Lentz's method.
+// y is 1 - x, which keeps the first term precise when x is close to 1.
+func betaContinuedFraction(a float64, b float64, x float64, y float64) float64 {
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if x > 0.5 {
+		d = (1 - b + qab*y) / qap
+	}
+	if math.Abs(d) < specialTiny {
+		d = specialTiny
+	}
+	d = 1 / d
+	h := d
+	for i := 1; i < specialMaxIter; i++ {
+		m := float64(i)
+		m2 := 2 * m
+		aa := m * (b - m) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < specialTiny {
+			d = specialTiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < specialTiny {
+			c = specialTiny
+		}
+		d = 1 / d
+		h *= d * c
+		aa = -(a + m) * (qab + m) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < specialTiny {
+			d = specialTiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < specialTiny {
+			c = specialTiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < specialEpsilon {
+			break
+		}
+	}
+	return h
+}
+
+// invRegIncBeta returns the x such that I_x(a, b) = p.
+// It starts from the approximation given in Numerical Recipes and refines it with Halley's method.
+func invRegIncBeta(a float64, b float64, p float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(p) || a <= 0 || b <= 0 || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 0 {
+		return 0
+	}
+	if p == 1 {
+		return 1
+	}
+	var x float64
+	a1, b1 := a-1, b-1
+	if a >= 1 && b >= 1 {
+		pp := p
+		if p >= 0.5 {
+			pp = 1 - p
+		}
+		t := math.Sqrt(-2 * math.Log(pp))
+		x = (2.30753+t*0.27061)/(1+t*(0.99229+t*0.04481)) - t
+		if p < 0.5 {
+			x = -x
+		}
+		al := (x*x - 3) / 6
+		h := 2 / (1/(2*a-1) + 1/(2*b-1))
+		w := x*math.Sqrt(al+h)/h - (1/(2*b-1)-1/(2*a-1))*(al+5.0/6-2/(3*h))
+		x = a / (a + b*math.Exp(2*w))
+	} else {
+		lna := math.Log(a / (a + b))
+		lnb := math.Log(b / (a + b))
+		t := math.Exp(a*lna) / a
+		u := math.Exp(b*lnb) / b
+		w := t + u
+		if p < t/w {
+			x = math.Pow(a*w*p, 1/a)
+		} else {
+			x = 1 - math.Pow(b*w*(1-p), 1/b)
+		}
+	}
+	afac := -lbeta(a, b)
+	for i := 0; i < 100; i++ {
+		if x == 0 || x == 1 {
+			return x
+		}
+		err := regIncBeta(a, b, x) - p
+		t := math.Exp(a1*math.Log(x) + b1*math.Log1p(-x) + afac)
+		u := err / t
+		t = u / (1 - 0.5*math.Min(1, u*(a1/x-b1/(1-x))))
+		x -= t
+		if x <= 0 {
+			x = 0.5 * (x + t)
+		}
+		if x >= 1 {
+			x = 0.5 * (x + t + 1)
+		}
+		if math.Abs(t) < 1e-14*x && i > 0 {
+			break
+		}
+	}
+	return x
+}
+
+// clampProbability returns p clamped to [0, 1]. The sums of probabilities, such as Poisson mixtures, can exceed
+// their bounds by a few rounding errors.
+func clampProbability(p float64) float64 {
+	return math.Max(0, math.Min(1, p))
+}
+
+// poissonMixture returns the sum over j >= 0 of w(j) term(j), where w(j) = exp(-lambda) lambda^(j+offset) / Gamma(j+offset+1)
+// are Poisson weights shifted by offset, and term is bounded by 1 in absolute value. It is the building block of the
+// noncentral distributions. The sum starts at the largest weight and goes both ways until the weights are negligible.
+func poissonMixture(lambda float64, offset float64, term func(j float64) float64) float64 {
+	if lambda == 0 {
+		if offset == 0 {
+			return term(0)
+		}
+		return 0
+	}
+	weight := func(j float64) float64 {
+		return math.Exp(-lambda + (j+offset)*math.Log(lambda) - lgamma(j+offset+1))
+	}
+	mode := math.Max(0, math.Floor(lambda-offset))
+	sum := 0.0
+	for j := mode; j < mode+specialMaxIter; j++ {
+		w := weight(j)
+		sum += w * term(j)
+		if (j > mode && w <= specialEpsilon*math.Abs(sum)) || w < specialTiny {
+			break
+		}
+	}
+	for j := mode - 1; j >= 0; j-- {
+		w := weight(j)
+		sum += w * term(j)
+		if w <= specialEpsilon*math.Abs(sum) || w < specialTiny {
+			break
+		}
+	}
+	return sum
+}
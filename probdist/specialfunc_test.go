@@ -0,0 +1,139 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_RegularizedGammaP(t *testing.T) {
+	type args struct {
+		a float64
+		x float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{"NaN case", args{math.NaN(), 1.0}, math.NaN()},
+		{"Invalid a case", args{0.0, 1.0}, math.NaN()},
+		{"Zero x case", args{2.0, 0.0}, 0.0},
+		{"Exponential case", args{1.0, 2.0}, 1 - math.Exp(-2.0)},
+		{"Half case", args{0.5, 2.0}, math.Erf(math.Sqrt(2.0))},
+		{"Series case", args{3.0, 2.0}, 1 - 5*math.Exp(-2.0)},
+		{"Continued fraction case", args{3.0, 10.0}, 1 - 61*math.Exp(-10.0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RegularizedGammaP(tt.args.a, tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("RegularizedGammaP() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-14 {
+				t.Errorf("RegularizedGammaP() = %v, want %v", got, tt.want)
+			}
+			if !math.IsNaN(got) && math.Abs(got+RegularizedGammaQ(tt.args.a, tt.args.x)-1) > 1e-14 {
+				t.Errorf("RegularizedGammaP() + RegularizedGammaQ() != 1")
+			}
+		})
+	}
+}
+
+func Test_regIncBeta(t *testing.T) {
+	type args struct {
+		a float64
+		b float64
+		x float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{"NaN case", args{math.NaN(), 1.0, 0.5}, math.NaN()},
+		{"Invalid b case", args{1.0, -1.0, 0.5}, math.NaN()},
+		{"Lower bound case", args{2.0, 3.0, 0.0}, 0.0},
+		{"Upper bound case", args{2.0, 3.0, 1.0}, 1.0},
+		{"Uniform case", args{1.0, 1.0, 0.3}, 0.3},
+		{"Integer case", args{2.0, 3.0, 0.4}, 0.5248},
+		{"Symmetric case", args{4.5, 4.5, 0.5}, 0.5},
+		{"Upper half case", args{2.0, 3.0, 0.9}, 0.9963},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := regIncBeta(tt.args.a, tt.args.b, tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("regIncBeta() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("regIncBeta() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_invRegIncBeta(t *testing.T) {
+	tests := []struct {
+		name string
+		a    float64
+		b    float64
+	}{
+		{"Uniform case", 1.0, 1.0},
+		{"Integer case", 2.0, 3.0},
+		{"Small parameters case", 0.5, 0.3},
+		{"Large parameters case", 150.0, 40.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range []float64{1e-12, 0.01, 0.3, 0.5, 0.9, 0.99} {
+				x := invRegIncBeta(tt.a, tt.b, p)
+				if got := regIncBeta(tt.a, tt.b, x); math.Abs(got-p) > 1e-10*math.Max(p, 1e-3) {
+					t.Errorf("regIncBeta(invRegIncBeta(%v)) = %v", p, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_invRegGammaP(t *testing.T) {
+	for _, a := range []float64{0.1, 0.5, 1.0, 2.5, 30.0, 1e4} {
+		for _, p := range []float64{1e-12, 0.01, 0.3, 0.5, 0.9, 0.999} {
+			x := invRegGammaP(a, p)
+			if got := RegularizedGammaP(a, x); math.Abs(got-p) > 1e-10*p {
+				t.Errorf("RegularizedGammaP(%v, invRegGammaP(%v)) = %v", a, p, got)
+			}
+		}
+	}
+}
+
+func TestRegularizedGammaQ(t *testing.T) {
+	type args struct {
+		a float64
+		x float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{"Invalid x case", args{1.0, -1.0}, math.NaN()},
+		{"Zero x case", args{2.0, 0.0}, 1.0},
+		{"Infinite x case", args{2.0, math.Inf(1)}, 0.0},
+		{"Exponential tail case", args{1.0, 50.0}, math.Exp(-50.0)},
+		{"Integer tail case", args{3.0, 60.0}, 1861 * math.Exp(-60.0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RegularizedGammaQ(tt.args.a, tt.args.x)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("RegularizedGammaQ() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-13*tt.want {
+				t.Errorf("RegularizedGammaQ() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package stats
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -16,10 +17,20 @@ func TestGetTStatistic(t *testing.T) {
 		want float64
 	}{
 		{"case 1", args{1.0, 0.1}, 3.078},
+		{"Table case", args{10.0, 0.025}, 2.228},
+		{"Large v case", args{1000.0, 0.05}, 1.646},
+		{"Real v case", args{2.5, 0.05}, 2.558},
+		{"Alpha out of table case", args{5.0, 0.3}, 0.559},
+		{"Invalid alpha case", args{5.0, 1.5}, math.NaN()},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GetTStatistic(tt.args.v, tt.args.alpha); got != tt.want {
+			got := GetTStatistic(tt.args.v, tt.args.alpha)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("GetTStatistic() = %v, want %v", got, tt.want)
+				}
+			} else if math.Abs(got-tt.want) > 1e-3 {
 				t.Errorf("GetTStatistic() = %v, want %v", got, tt.want)
 			}
 		})
@@ -39,7 +50,9 @@ func TestNewStudentsT(t *testing.T) {
 		{"Normal case", args{1.0}, StudentsT{1.0}, false},
 		{"Invalid negative case", args{-1.0}, StudentsT{}, true},
 		{"Invalid zero case", args{0.0}, StudentsT{}, true},
-		{"Invalid real case", args{2.5}, StudentsT{}, true},
+		{"Real case", args{2.5}, StudentsT{2.5}, false},
+		{"NaN case", args{math.NaN()}, StudentsT{}, true},
+		{"Infinite case", args{math.Inf(1)}, StudentsT{math.Inf(1)}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -60,3 +73,94 @@ func BenchmarkNewStudentsT(b *testing.B) {
 		NewStudentsT(1.0)
 	}
 }
+
+func Test_studentsT_CDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		st   StudentsT
+		args args
+		want float64
+	}{
+		{"Cauchy case", StudentsT{1}, args{1.0}, 0.75},
+		{"Median case", StudentsT{5}, args{0.0}, 0.5},
+		{"Closed form case", StudentsT{2}, args{1.0}, 0.788675},
+		{"Negative input case", StudentsT{2}, args{-1.0}, 0.211325},
+		{"Table case", StudentsT{10}, args{2.228}, 0.975},
+		{"Large V case", StudentsT{1e12}, args{1.959963984542426}, 0.975},
+		{"Huge V tail case", StudentsT{1e16}, args{-8.0}, 6.220960574271819e-16},
+		{"Normal limit case", StudentsT{math.Inf(1)}, args{1.96}, 0.9750021048517795},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.st.CDF(tt.args.x); math.Abs(got-tt.want) > 1e-5*math.Min(1, tt.want) {
+				t.Errorf("CDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_studentsT_PDF(t *testing.T) {
+	type args struct {
+		x float64
+	}
+	tests := []struct {
+		name string
+		st   StudentsT
+		args args
+		want float64
+	}{
+		{"Cauchy case", StudentsT{1}, args{0.0}, 0.318310},
+		{"Cauchy case 2", StudentsT{1}, args{1.0}, 0.159155},
+		{"Closed form case", StudentsT{2}, args{1.0}, 0.192450},
+		{"Large V case", StudentsT{1e14}, args{0.0}, 0.3989422804014317},
+		{"Huge V case", StudentsT{1e300}, args{2.0}, 0.05399096651318806},
+		{"Normal limit case", StudentsT{math.Inf(1)}, args{1.0}, 0.24197072451914337},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.st.PDF(tt.args.x); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("PDF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_studentsT_Quantile(t *testing.T) {
+	type args struct {
+		p float64
+	}
+	tests := []struct {
+		name string
+		st   StudentsT
+		args args
+		want float64
+	}{
+		{"Cauchy case", StudentsT{1}, args{0.75}, 1.0},
+		{"Median case", StudentsT{7}, args{0.5}, 0.0},
+		{"Closed form case", StudentsT{2}, args{0.788675134594813}, 1.0},
+		{"Lower tail case", StudentsT{2}, args{0.211324865405187}, -1.0},
+		{"Far tail case", StudentsT{1}, args{1e-10}, -1 / math.Tan(math.Pi*1e-10)},
+		{"Center case", StudentsT{1}, args{0.5 + 1e-10}, math.Tan(math.Pi * 1e-10)},
+		{"Large V case", StudentsT{1e12}, args{0.975}, 1.959963984542426},
+		{"Large V critical value case", StudentsT{1e12}, args{0.95}, 1.644853626952996},
+		{"Huge V case", StudentsT{1e16}, args{0.975}, 1.959963984540054},
+		{"Normal limit case", StudentsT{math.Inf(1)}, args{0.025}, -1.959963984540054},
+		{"Bound case", StudentsT{3}, args{1.0}, math.Inf(1)},
+		{"Invalid case", StudentsT{3}, args{1.5}, math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.st.Quantile(tt.args.p)
+			if math.IsNaN(got) || math.IsNaN(tt.want) {
+				if !math.IsNaN(got) || !math.IsNaN(tt.want) {
+					t.Errorf("Quantile() = %v, want %v", got, tt.want)
+				}
+			} else if got != tt.want && math.Abs(got-tt.want) > 1e-9*math.Max(1, math.Abs(tt.want)) {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
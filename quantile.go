@@ -0,0 +1,222 @@
+package stats
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// QuantileMethod represents one of the nine sample quantile definitions of Hyndman and Fan (1996),
+// numbered as the types of the R quantile function.
+type QuantileMethod uint8
+
+const (
+	// QuantileDefault is QuantileType7, the default of R, NumPy and most spreadsheets.
+	QuantileDefault QuantileMethod = iota
+	// QuantileType1 is the inverse of the empirical CDF.
+	QuantileType1
+	// QuantileType2 is the inverse of the empirical CDF, averaging the two values at discontinuities.
+	QuantileType2
+	// QuantileType3 is the observation closest to n p, the even one on ties, as in SAS.
+	QuantileType3
+	// QuantileType4 interpolates linearly the empirical CDF.
+	QuantileType4
+	// QuantileType5 interpolates linearly between the points ((k - 0.5) / n, x[k]).
+	QuantileType5
+	// QuantileType6 interpolates linearly between the points (k / (n + 1), x[k]), as in Minitab and SPSS.
+	QuantileType6
+	// QuantileType7 interpolates linearly between the points ((k - 1) / (n - 1), x[k]).
+	QuantileType7
+	// QuantileType8 is approximately median-unbiased whatever the distribution, the one recommended by Hyndman and Fan.
+	QuantileType8
+	// QuantileType9 is approximately unbiased for the expected order statistics of a normal distribution.
+	QuantileType9
+)
+
+// quantileFuzz, scaled by n, absorbs the rounding errors of n p, so that p = k/n gives exactly the k-th value.
+const quantileFuzz = 4 * 2.220446049250313e-16
+
+// quantileParameters holds the (a, b) parameters of the continuous methods QuantileType4 to QuantileType9, which
+// interpolate linearly between the points ((k - a) / (n + 1 - a - b), x[k]).
+var quantileParameters = [6][2]float64{{0, 1}, {0.5, 0.5}, {0, 0}, {1, 1}, {1.0 / 3, 1.0 / 3}, {3.0 / 8, 3.0 / 8}}
+
+// Quantile returns the p-quantile of the sample with the given method. The input does not need to be sorted
+// and is not modified: the order statistics are found by selection in a copy, in expected linear time.
+// It returns NaN if the input is empty, contains NaN or p is not in [0, 1].
+func Quantile(input []float64, p float64, method QuantileMethod) float64 {
+	return Quantiles(input, []float64{p}, method)[0]
+}
+
+// Quantiles returns the quantiles of the sample for each probability of ps with the given method.
+// The input is not modified.
+func Quantiles(input []float64, ps []float64, method QuantileMethod) []float64 {
+	x := make([]float64, len(input))
+	copy(x, input)
+	return QuantilesInPlace(x, ps, method)
+}
+
+// QuantileInPlace is like Quantile but avoids the copy of the input by reordering it.
+func QuantileInPlace(input []float64, p float64, method QuantileMethod) float64 {
+	return QuantilesInPlace(input, []float64{p}, method)[0]
+}
+
+// QuantilesInPlace is like Quantiles but avoids the copy of the input by reordering it.
+// Each selection only searches above the previous order statistic, so a few quantiles cost little more than one.
+func QuantilesInPlace(input []float64, ps []float64, method QuantileMethod) []float64 {
+	if method > QuantileType9 {
+		panic("stats: incorrect quantile method. Try QuantileDefault or QuantileType1 to QuantileType9")
+	}
+	out := make([]float64, len(ps))
+	var order []int
+	for i, p := range ps {
+		out[i] = math.NaN()
+		if !math.IsNaN(p) && p >= 0 && p <= 1 {
+			order = append(order, i)
+		}
+	}
+	if len(input) == 0 || hasNaN(input) {
+		return out
+	}
+	sort.SliceStable(order, func(i, j int) bool { return ps[order[i]] < ps[order[j]] })
+
+	// input[start:] holds the values not smaller than the order statistics already selected.
+	start := 0
+	for _, i := range order {
+		lo, hi, h := quantilePosition(len(input), ps[i], method)
+		if lo >= start {
+			selectInPlace(input[start:], lo-start)
+			start = lo
+		}
+		if h == 0 {
+			out[i] = input[lo]
+			continue
+		}
+		if hi > start {
+			selectInPlace(input[start+1:], hi-start-1)
+			start = hi
+		}
+		// The extreme weights are handled apart so infinite values do not give NaN.
+		if h == 1 {
+			out[i] = input[hi]
+		} else {
+			out[i] = input[lo] + h*(input[hi]-input[lo])
+		}
+	}
+	return out
+}
+
+// quantilePosition returns the 0-based indices in the sorted sample of size n of the two order statistics
+// whose interpolation gives the p-quantile with the given method, (1 - h) x[lo] + h x[hi].
+func quantilePosition(n int, p float64, method QuantileMethod) (int, int, float64) {
+	nf := float64(n)
+	// Following R, the indices are 1-based and clamped to [1, n].
+	var nppm, j, h float64
+	fuzz := quantileFuzz * math.Max(1, nf)
+	if method == QuantileDefault {
+		method = QuantileType7
+	}
+	switch method {
+	case QuantileType1, QuantileType2, QuantileType3:
+		nppm = nf * p
+		if method == QuantileType3 {
+			nppm -= 0.5
+		}
+		j = math.Floor(nppm + fuzz)
+		switch {
+		case nppm <= j+fuzz && method == QuantileType2:
+			h = 0.5
+		case nppm <= j+fuzz && (method == QuantileType1 || math.Mod(j, 2) == 0):
+			h = 0
+		default:
+			h = 1
+		}
+	default:
+		a, b := quantileParameters[method-QuantileType4][0], quantileParameters[method-QuantileType4][1]
+		nppm = a + p*(nf+1-a-b)
+		j = math.Floor(nppm + fuzz)
+		h = nppm - j
+		if math.Abs(h) < fuzz {
+			h = 0
+		}
+	}
+
+	lo, hi := clampIndex(j, n), clampIndex(j+1, n)
+	if lo == hi {
+		h = 0
+	}
+	return lo, hi, h
+}
+
+// clampIndex returns the 0-based index of the 1-based index j clamped to [1, n].
+func clampIndex(j float64, n int) int {
+	if j < 1 {
+		return 0
+	}
+	if j > float64(n) {
+		return n - 1
+	}
+	return int(j) - 1
+}
+
+// hasNaN reports whether input contains NaN.
+func hasNaN(input []float64) bool {
+	for _, v := range input {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectInPlace reorders x so that x[k] is its k-th smallest value (0-based), with x[:k] <= x[k] <= x[k+1:].
+// It is an introselect: a quickselect with median of three pivots that falls back to sorting when the partitions
+// shrink too slowly, so it runs in expected linear time and O(n log n) in the worst case. x must not contain NaN.
+func selectInPlace(x []float64, k int) {
+	lo, hi := 0, len(x)-1
+	budget := 2 * bits.Len(uint(len(x)))
+	for hi > lo {
+		if budget == 0 {
+			sort.Float64s(x[lo : hi+1])
+			return
+		}
+		budget--
+
+		// Order x[lo], x[mid], x[hi] and take the middle one as the pivot.
+		mid := lo + (hi-lo)/2
+		if x[mid] < x[lo] {
+			x[mid], x[lo] = x[lo], x[mid]
+		}
+		if x[hi] < x[lo] {
+			x[hi], x[lo] = x[lo], x[hi]
+		}
+		if x[hi] < x[mid] {
+			x[hi], x[mid] = x[mid], x[hi]
+		}
+		pivot := x[mid]
+
+		// Three-way partition: x[lo:lt] < pivot, x[lt:gt+1] == pivot, x[gt+1:hi+1] > pivot,
+		// which keeps the many duplicates of rounded measurements from degrading the selection.
+		lt, i, gt := lo, lo, hi
+		for i <= gt {
+			switch {
+			case x[i] < pivot:
+				x[lt], x[i] = x[i], x[lt]
+				lt++
+				i++
+			case x[i] > pivot:
+				x[gt], x[i] = x[i], x[gt]
+				gt--
+			default:
+				i++
+			}
+		}
+		switch {
+		case k < lt:
+			hi = lt - 1
+		case k > gt:
+			lo = gt + 1
+		default:
+			return
+		}
+	}
+}
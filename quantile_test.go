@@ -0,0 +1,241 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestQuantile(t *testing.T) {
+	sample := []float64{2.5, 7, 1, 9.25, 4, 4, 12, 3.5, 6, 10.5, 8}
+	small := []float64{4, 1, 3, 2}
+	tests := []struct {
+		name  string
+		input []float64
+		p     float64
+		// want holds the expected quantile of each type from 1 to 9.
+		want [9]float64
+	}{
+		{"Minimum case", sample, 0, [9]float64{1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{"Decile case", sample, 0.1, [9]float64{2.5, 2.5, 1, 1.15, 1.9, 1.3, 2.5, 1.7, 1.75}},
+		{"Quartile case", sample, 0.25, [9]float64{3.5, 3.5, 3.5, 3.25, 3.625, 3.5, 3.75, 3.5833333333, 3.59375}},
+		{"Median case", sample, 0.5, [9]float64{6, 6, 6, 5, 6, 6, 6, 6, 6}},
+		{"Upper decile case", sample, 0.9, [9]float64{10.5, 10.5, 10.5, 10.375, 11.1, 11.7, 10.5, 11.3, 11.25}},
+		{"Maximum case", sample, 1, [9]float64{12, 12, 12, 12, 12, 12, 12, 12, 12}},
+		{"Discontinuity case", small, 0.25, [9]float64{1, 1.5, 1, 1, 1.5, 1.25, 1.75, 1.4166666667, 1.4375}},
+		{"Odd closest case", small, 0.375, [9]float64{2, 2, 2, 1.5, 2, 1.875, 2.125, 1.9583333333, 1.96875}},
+		{"Even median case", small, 0.5, [9]float64{2, 2.5, 2, 2, 2.5, 2.5, 2.5, 2.5, 2.5}},
+		{"Even closest case", small, 0.625, [9]float64{3, 3, 2, 2.5, 3, 3.125, 2.875, 3.0416666667, 3.03125}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.want {
+				method := QuantileMethod(i + 1)
+				if got := Quantile(tt.input, tt.p, method); math.Abs(got-want) > 1e-9 {
+					t.Errorf("Quantile(%v, type %d) = %v, want %v", tt.p, method, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestQuantile_Default(t *testing.T) {
+	sample := []float64{2.5, 7, 1, 9.25, 4, 4, 12, 3.5, 6, 10.5, 8}
+	for _, p := range []float64{0, 0.05, 0.5, 0.95, 0.99, 1} {
+		if got, want := Quantile(sample, p, QuantileDefault), Quantile(sample, p, QuantileType7); got != want {
+			t.Errorf("Quantile(%v, QuantileDefault) = %v, want %v", p, got, want)
+		}
+	}
+	if sample[0] != 2.5 || sample[10] != 8 {
+		t.Errorf("Quantile() modified its input: %v", sample)
+	}
+}
+
+func TestQuantile_Exact(t *testing.T) {
+	// p = k/n must give exactly the k-th value despite the rounding of n p.
+	sample := make([]float64, 100)
+	for i := range sample {
+		sample[i] = float64(i + 1)
+	}
+	for k := 1; k <= 100; k++ {
+		if got := Quantile(sample, float64(k)/100, QuantileType1); got != float64(k) {
+			t.Errorf("Quantile(%v, QuantileType1) = %v, want %v", float64(k)/100, got, k)
+		}
+	}
+}
+
+func TestQuantile_Special(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		p     float64
+		want  float64
+	}{
+		{"Empty case", []float64{}, 0.5, math.NaN()},
+		{"NaN value case", []float64{1, math.NaN(), 3}, 0.5, math.NaN()},
+		{"NaN probability case", []float64{1, 2, 3}, math.NaN(), math.NaN()},
+		{"Negative probability case", []float64{1, 2, 3}, -0.1, math.NaN()},
+		{"Too large probability case", []float64{1, 2, 3}, 1.1, math.NaN()},
+		{"Single value case", []float64{5}, 0.3, 5},
+		{"Infinite value case", []float64{1, 2, math.Inf(1)}, 0.5, 2},
+		{"Infinite quantile case", []float64{1, 2, math.Inf(1)}, 0.75, math.Inf(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Quantile(tt.input, tt.p, QuantileDefault)
+			if math.IsNaN(got) != math.IsNaN(tt.want) || (!math.IsNaN(got) && got != tt.want) {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	sample := []float64{2.5, 7, 1, 9.25, 4, 4, 12, 3.5, 6, 10.5, 8}
+	ps := []float64{0.5, 0.95, 0.99}
+	got := Quantiles(sample, ps, QuantileType8)
+	if len(got) != len(ps) {
+		t.Fatalf("Quantiles() returned %d values, want %d", len(got), len(ps))
+	}
+	for i, p := range ps {
+		if want := Quantile(sample, p, QuantileType8); got[i] != want {
+			t.Errorf("Quantiles()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestQuantile_Panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Quantile() with an unknown method should panic")
+		}
+	}()
+	Quantile([]float64{1, 2, 3}, 0.5, QuantileType9+1)
+}
+
+func TestSelectInPlace(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	inputs := map[string]func(n int) []float64{
+		"random": func(n int) []float64 {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			return x
+		},
+		"duplicates": func(n int) []float64 {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = float64(rnd.Intn(5))
+			}
+			return x
+		},
+		"sorted": func(n int) []float64 {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = float64(i)
+			}
+			return x
+		},
+		"reversed": func(n int) []float64 {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = float64(n - i)
+			}
+			return x
+		},
+		"organ pipe": func(n int) []float64 {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = math.Min(float64(i), float64(n-i))
+			}
+			return x
+		},
+	}
+	for name, gen := range inputs {
+		for _, n := range []int{1, 2, 3, 10, 101, 1000} {
+			x := gen(n)
+			want := sortedCopy(x)
+			for _, k := range []int{0, n / 3, n / 2, n - 1} {
+				y := make([]float64, n)
+				copy(y, x)
+				selectInPlace(y, k)
+				if y[k] != want[k] {
+					t.Fatalf("selectInPlace(%v, n = %d, k = %d) = %v, want %v", name, n, k, y[k], want[k])
+				}
+				for i := range y {
+					if (i < k && y[i] > y[k]) || (i > k && y[i] < y[k]) {
+						t.Fatalf("selectInPlace(%v, n = %d, k = %d) did not partition around k", name, n, k)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestQuantilesInPlace(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	ps := []float64{0.99, 0.5, 0.25, 0.5, 0, 1, 0.95, -1}
+	for _, n := range []int{1, 2, 7, 100, 1001} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = math.Round(rnd.ExpFloat64() * 10)
+		}
+		sorted := sortedCopy(x)
+		for method := QuantileType1; method <= QuantileType9; method++ {
+			y := make([]float64, n)
+			copy(y, x)
+			got := QuantilesInPlace(y, ps, method)
+			for i, p := range ps {
+				want := math.NaN()
+				if p >= 0 {
+					lo, hi, h := quantilePosition(n, p, method)
+					want = sorted[lo] + h*(sorted[hi]-sorted[lo])
+				}
+				if math.IsNaN(got[i]) != math.IsNaN(want) || math.Abs(got[i]-want) > 1e-12 {
+					t.Errorf("QuantilesInPlace(n = %d, p = %v, type %d) = %v, want %v", n, p, method, got[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestMedianInPlace(t *testing.T) {
+	x := []float64{4, 3, 5, 1, 2, 6}
+	y := []float64{4, 3, 5, 1, 2, 6}
+	if got := Median(x); got != 3.5 {
+		t.Errorf("Median() = %v, want 3.5", got)
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			t.Fatalf("Median() modified its input: %v", x)
+		}
+	}
+	if got := MedianInPlace(x); got != 3.5 {
+		t.Errorf("MedianInPlace() = %v, want 3.5", got)
+	}
+	if got := MedianInPlace([]float64{}); !math.IsNaN(got) {
+		t.Errorf("MedianInPlace() = %v, want NaN", got)
+	}
+	// The median of a single value is that value, as for Quartile2.
+	single := []float64{7}
+	if Median(single) != 7 || MedianInPlace(single) != 7 || Quartile2(single) != Median(single) {
+		t.Errorf("Median(), MedianInPlace(), Quartile2() of a single value = %v, %v, %v, want 7",
+			Median(single), MedianInPlace(single), Quartile2(single))
+	}
+}
+
+func benchmarkQuantile(len int, b *testing.B) {
+	s := make([]float64, len)
+	for e := 0; e <= len-1; e++ {
+		s[e] = rand.Float64()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Quantile(s, 0.99, QuantileDefault)
+	}
+}
+
+func BenchmarkQuantile10(b *testing.B)  { benchmarkQuantile(10, b) }
+func BenchmarkQuantile1e3(b *testing.B) { benchmarkQuantile(1e3, b) }
+func BenchmarkQuantile1e6(b *testing.B) { benchmarkQuantile(1e6, b) }
@@ -21,50 +21,49 @@ const (
 // OneSampleZTest performs a Z Test.
 // Z is the Standard normal distribution N(0,1).
 // This test can be performed when the population is normally distributed and the population variance is known.
-// It returns true if the null hypothesis is accepted and false otherwise.
-// alpha is the significance level of one tail.
-// Right tail (1):
+// The z score (sample mean - pop.Mu) / (pop.Sigma / sqrt(n)) is evaluated on N(0,1), and the two tailed
+// p-value is twice the one of the smaller tail.
+// alpha is the significance level of the test.
+// The result includes the Z confidence interval of the population mean and Cohen's d = (sample mean - pop.Mu) / pop.Sigma.
+// Right tail :
 // Null hypothesis Ho :  sample mean <= pop mean.
 // Alternative hypothesis H1 : sample mean > pop mean.
-// Two tails (2):
+// Two tails :
 // Null hypothesis Ho :  sample mean = pop mean.
 // Alternative hypothesis H1 : sample mean != pop mean.
-// Left tail (-1):
+// Left tail :
 // Null hypothesis Ho :  sample mean >= pop mean.
 // Alternative hypothesis H1 : sample mean < pop mean.
-func OneSampleZTest(sample []float64, pop pd.Normal, alpha float64, tails TailDirection) (bool, float64) {
+func OneSampleZTest(sample []float64, pop pd.Normal, alpha float64, tails TailDirection) TestResult {
 	smean := Mean(sample)
-	zscore := (smean - pop.Mu) / (pop.Sigma / math.Sqrt(float64(len(sample))))
+	se := pop.Sigma / math.Sqrt(float64(len(sample)))
+	zscore := (smean - pop.Mu) / se
+	std := pd.StandardNormal()
 
+	var pvalue float64
 	switch tails {
 	case TailRight:
-		pvalue := 1 - pop.CDF(zscore)
-		if pvalue < alpha {
-			return false, pvalue
-		}
-		return true, pvalue
+		pvalue = std.Survival(zscore)
 	case TailBoth:
-		//symmetric, we can use just one tail.
-		pvalue := 1 - pop.CDF(zscore)
-		if pvalue < alpha {
-			return false, pvalue
-		}
-		return true, pvalue
+		pvalue = math.Min(1, 2*std.Survival(math.Abs(zscore)))
 	case TailLeft:
-		pvalue := pop.CDF(-zscore)
-		if pvalue < alpha {
-			return false, pvalue
-		}
-		return true, pvalue
+		pvalue = std.CDF(zscore)
 	default:
 		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
 	}
+	res := newTestResult("One sample z test", "z", zscore, pvalue, alpha, tails)
+	res.CriticalValues = criticalValues(std, alpha, tails)
+	res.ConfidenceInterval = locationInterval(smean, se, std, alpha, tails)
+	res.EffectSizeName = "d"
+	res.EffectSize = (smean - pop.Mu) / pop.Sigma
+	return res
 }
 
 // OneSampleTTest performs a One Sample T Test.
 // This test can be performed when we don't know the populations std dev.
-// It returns true if the null hypoyhesis is accepted and false otherwise.
-// alpha is the significance level of one tail.
+// The p-value is exact, computed from the Student's t distribution with n-1 degrees of freedom.
+// alpha is the significance level of the test. For two tails the p-value already accounts for both of them.
+// The result includes the confidence interval of the population mean and Cohen's d = (mean - popmean) / s.
 // Right tail :
 // Null hypothesis Ho :  sample mean <= pop mean.
 // Alternative hypothesis H1 : sample mean > pop mean.
@@ -74,70 +73,385 @@ func OneSampleZTest(sample []float64, pop pd.Normal, alpha float64, tails TailDi
 // Left tail :
 // Null hypothesis Ho :  sample mean >= pop mean.
 // Alternative hypothesis H1 : sample mean < pop mean.
-func OneSampleTTest(sample []float64, popmean float64, alpha float64, tails TailDirection) (bool, float64) {
+func OneSampleTTest(sample []float64, popmean float64, alpha float64, tails TailDirection) TestResult {
+	res := oneSampleTTest(sample, popmean, alpha, tails)
+	res.Name = "One sample t test"
+	return res
+}
+
+// PairedTTest performs a Paired T Test on the differences presample - postsample.
+// The hypotheses for each tail are the same as in OneSampleTTest, using the mean of the differences against 0.
+// The confidence interval is the one of the mean difference and the effect size is Cohen's d of the differences.
+func PairedTTest(presample []float64, postsample []float64, alpha float64, tails TailDirection) TestResult {
+	if len(presample) != len(postsample) {
+		panic("stats: incorrect samples length")
+	}
+	diffsample := make([]float64, len(presample))
+	for i, e := range presample {
+		diffsample[i] = e - postsample[i]
+	}
+	res := oneSampleTTest(diffsample, 0, alpha, tails)
+	res.Name = "Paired t test"
+	return res
+}
+
+// TwoSampleTTest performs an independent Two Sample T Test on the difference of the means mean(a) - mean(b).
+// If equalVar is true, the variances of both populations are assumed equal and the pooled variance is used,
+// with n1+n2-2 degrees of freedom (Student). Otherwise the standard error is computed from each sample variance
+// and the degrees of freedom are given by the Welch-Satterthwaite equation, which are usually fractional (Welch).
+// The p-value is exact, computed from the Student's t distribution.
+// The result includes the confidence interval of the mean difference and Cohen's d computed with the pooled standard deviation.
+// Right tail :
+// Null hypothesis Ho :  mean a <= mean b.
+// Alternative hypothesis H1 : mean a > mean b.
+// Two tails :
+// Null hypothesis Ho :  mean a = mean b.
+// Alternative hypothesis H1 : mean a != mean b.
+// Left tail :
+// Null hypothesis Ho :  mean a >= mean b.
+// Alternative hypothesis H1 : mean a < mean b.
+func TwoSampleTTest(a []float64, b []float64, equalVar bool, alpha float64, tails TailDirection) TestResult {
+	if len(a) < 2 || len(b) < 2 {
+		panic("stats: each sample needs at least two values")
+	}
+	n1, n2 := float64(len(a)), float64(len(b))
+	diff := Mean(a) - Mean(b)
+	v1, v2 := Variance(a), Variance(b)
+	pooled := ((n1-1)*v1 + (n2-1)*v2) / (n1 + n2 - 2)
+
+	var se, df float64
+	name := "Welch two sample t test"
+	if equalVar {
+		name = "Two sample t test"
+		se = math.Sqrt(pooled * (1/n1 + 1/n2))
+		df = n1 + n2 - 2
+	} else {
+		e1, e2 := v1/n1, v2/n2
+		se = math.Sqrt(e1 + e2)
+		df = (e1 + e2) * (e1 + e2) / (e1*e1/(n1-1) + e2*e2/(n2-1))
+	}
+	tscore := diff / se
+	dist := pd.StudentsT{V: df}
+	res := newTestResult(name, "t", tscore, tTestPValue(tscore, df, tails), alpha, tails)
+	res.DF = df
+	res.CriticalValues = criticalValues(dist, alpha, tails)
+	res.ConfidenceInterval = locationInterval(diff, se, dist, alpha, tails)
+	res.EffectSizeName = "d"
+	res.EffectSize = diff / math.Sqrt(pooled)
+	return res
+}
+
+// oneSampleTTest returns the t test result of the sample mean against popmean.
+func oneSampleTTest(sample []float64, popmean float64, alpha float64, tails TailDirection) TestResult {
 	mean := Mean(sample)
 	s := StdDev(sample)
 	n := float64(len(sample))
-	tscore := (mean - popmean) / (s / math.Sqrt(n))
+	se := s / math.Sqrt(n)
+	tscore := (mean - popmean) / se
+	dist := pd.StudentsT{V: n - 1}
+	res := newTestResult("", "t", tscore, tTestPValue(tscore, n-1, tails), alpha, tails)
+	res.DF = n - 1
+	res.CriticalValues = criticalValues(dist, alpha, tails)
+	res.ConfidenceInterval = locationInterval(mean, se, dist, alpha, tails)
+	res.EffectSizeName = "d"
+	res.EffectSize = (mean - popmean) / s
+	return res
+}
+
+// tTestPValue returns the p-value of a t score with v degrees of freedom for the given tails.
+func tTestPValue(tscore float64, v float64, tails TailDirection) float64 {
+	dist := pd.StudentsT{V: v}
 	switch tails {
 	case TailRight:
-		tcritical := pd.GetTStatistic(n-1, alpha)
-		if tscore > tcritical {
-			return false, tcritical
-		}
-		return true, tcritical
+		return dist.Survival(tscore)
 	case TailBoth:
-		utcritical := pd.GetTStatistic(n-1, 2*alpha)
-		ltcritical := -utcritical
-		if tscore > utcritical || tscore < ltcritical {
-			return false, utcritical
-		}
-		return true, utcritical
+		return math.Min(1, 2*dist.Survival(math.Abs(tscore)))
+	case TailLeft:
+		return dist.CDF(tscore)
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
+
+// PoissonRateTest compares the rates of two poisson processes with the exact conditional binomial test.
+// count1 events were observed during exposure1 and count2 events during exposure2 (e.g. weeks).
+// Conditional on the total count1+count2, count1 follows a binomial distribution with
+// P = exposure1/(exposure1+exposure2) when both rates are equal.
+// The statistic is count1, the effect size is the rate ratio RR = (count1/exposure1)/(count2/exposure2)
+// and the confidence interval is the exact (Clopper-Pearson) interval of the rate ratio.
+// Right tail :
+// Null hypothesis Ho :  rate1 <= rate2.
+// Alternative hypothesis H1 : rate1 > rate2.
+// Two tails :
+// Null hypothesis Ho :  rate1 = rate2.
+// Alternative hypothesis H1 : rate1 != rate2.
+// Left tail :
+// Null hypothesis Ho :  rate1 >= rate2.
+// Alternative hypothesis H1 : rate1 < rate2.
+func PoissonRateTest(count1 float64, exposure1 float64, count2 float64, exposure2 float64, alpha float64, tails TailDirection) TestResult {
+	if count1 < 0 || count2 < 0 || math.Mod(count1, 1.0) != 0 || math.Mod(count2, 1.0) != 0 || !(exposure1 > 0) || !(exposure2 > 0) {
+		panic("stats: invalid poisson rate test parameters")
+	}
+	n := count1 + count2
+	bin := pd.Binomial{N: n, P: exposure1 / (exposure1 + exposure2)}
+	res := newTestResult("Poisson rate test", "x", count1, binomialTestPValue(count1, bin, tails), alpha, tails)
+	lower, upper := clopperPearson(count1, n, alpha, tails)
+	// Convert the interval of the binomial proportion into an interval of the rate ratio.
+	res.ConfidenceInterval = pd.Interval{
+		Min: lower / (1 - lower) * exposure2 / exposure1,
+		Max: upper / (1 - upper) * exposure2 / exposure1,
+	}
+	res.EffectSizeName = "RR"
+	res.EffectSize = (count1 / exposure1) / (count2 / exposure2)
+	return res
+}
+
+// clopperPearson returns the exact 1-alpha confidence interval of a binomial proportion after k successes in n trials.
+func clopperPearson(k float64, n float64, alpha float64, tails TailDirection) (float64, float64) {
+	lowerAlpha, upperAlpha := alpha/2, alpha/2
+	switch tails {
+	case TailRight:
+		lowerAlpha, upperAlpha = alpha, 0
+	case TailLeft:
+		lowerAlpha, upperAlpha = 0, alpha
+	}
+	lower, upper := 0.0, 1.0
+	if k > 0 && lowerAlpha > 0 {
+		lower = pd.Beta{Alpha: k, Beta: n - k + 1}.Quantile(lowerAlpha)
+	}
+	if k < n && upperAlpha > 0 {
+		upper = pd.Beta{Alpha: k + 1, Beta: n - k}.Quantile(1 - upperAlpha)
+	}
+	return lower, upper
+}
+
+// binomialTestPValue returns the exact p-value of observing k successes under bin.
+// The two tailed p-value adds the probabilities of all the outcomes that are not more likely than k.
+func binomialTestPValue(k float64, bin pd.Binomial, tails TailDirection) float64 {
+	switch tails {
+	case TailRight:
+		return bin.Survival(k - 1)
 	case TailLeft:
-		tcritical := -pd.GetTStatistic(n-1, alpha)
-		if tscore < tcritical {
-			return false, tcritical
+		return bin.CDF(k)
+	case TailBoth:
+		// Relative tolerance to consider ties between outcomes with the same probability.
+		const relErr = 1 + 1e-7
+		d := bin.PMF(k)
+		m := bin.Mean()
+		var pvalue float64
+		switch {
+		case k == m:
+			return 1
+		case k < m:
+			y := 0.0
+			for i := math.Ceil(m); i <= bin.N; i++ {
+				if bin.PMF(i) <= d*relErr {
+					y++
+				}
+			}
+			pvalue = bin.CDF(k) + bin.Survival(bin.N-y)
+		default:
+			y := 0.0
+			for i := 0.0; i <= math.Floor(m); i++ {
+				if bin.PMF(i) <= d*relErr {
+					y++
+				}
+			}
+			pvalue = bin.CDF(y-1) + bin.Survival(k-1)
 		}
-		return true, tcritical
+		return math.Min(1, pvalue)
 	default:
-		panic("stats: incorrect tails input value. Try Try TailRight, TailLeft or TailBoth")
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
 	}
 }
 
-// PairedTTest function returns the result of a Pared T Test
-func PairedTTest(presample []float64, postsample []float64, alpha float64, tails TailDirection) (bool, float64, float64) {
-	if len(presample) != len(postsample) {
+// ChiSquareGoodnessOfFit performs Pearson's chi-squared goodness of fit test.
+// observed and expected are the counts of each category. If the expected counts do not add up to
+// the observed total they are rescaled, so expected can also be given as proportions.
+// The result has categories - 1 degrees of freedom and Cohen's w = sqrt(χ²/n) as effect size.
+// Null hypothesis Ho : the observed counts follow the expected distribution.
+// Alternative hypothesis H1 : the observed counts do not follow the expected distribution.
+func ChiSquareGoodnessOfFit(observed []float64, expected []float64, alpha float64) TestResult {
+	if len(observed) != len(expected) {
 		panic("stats: incorrect samples length")
 	}
-	diffsample := make([]float64, len(presample))
-	for i, e := range presample {
-		diffsample = append(diffsample, e-postsample[i])
+	if len(observed) < 2 {
+		panic("stats: at least two categories are needed")
+	}
+	var totalObserved, totalExpected float64
+	for i := range observed {
+		if observed[i] < 0 || !(expected[i] > 0) {
+			panic("stats: observed counts must be >= 0 and expected counts > 0")
+		}
+		totalObserved += observed[i]
+		totalExpected += expected[i]
+	}
+	scale := totalObserved / totalExpected
+	statistic := 0.0
+	for i := range observed {
+		e := expected[i] * scale
+		statistic += (observed[i] - e) * (observed[i] - e) / e
+	}
+	df := float64(len(observed) - 1)
+	dist := pd.ChiSquared{K: df}
+	res := newTestResult("Chi-squared goodness of fit test", "χ²", statistic, dist.Survival(statistic), alpha, TailRight)
+	res.DF = df
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	res.EffectSizeName = "w"
+	res.EffectSize = math.Sqrt(statistic / totalObserved)
+	return res
+}
+
+// ChiSquareIndependence performs Pearson's chi-squared test of independence on an r x c contingency table.
+// table[i][j] is the count of observations in row category i and column category j.
+// The result has (r-1)(c-1) degrees of freedom and Cramér's V as effect size.
+// Null hypothesis Ho : the row and column variables are independent.
+// Alternative hypothesis H1 : the row and column variables are not independent.
+func ChiSquareIndependence(table [][]float64, alpha float64) TestResult {
+	r := len(table)
+	if r < 2 || len(table[0]) < 2 {
+		panic("stats: the contingency table must be at least 2x2")
+	}
+	c := len(table[0])
+	rowTotals := make([]float64, r)
+	colTotals := make([]float64, c)
+	total := 0.0
+	for i, row := range table {
+		if len(row) != c {
+			panic("stats: the contingency table rows must have the same length")
+		}
+		for j, o := range row {
+			if o < 0 {
+				panic("stats: counts must be >= 0")
+			}
+			rowTotals[i] += o
+			colTotals[j] += o
+			total += o
+		}
+	}
+	statistic := 0.0
+	for i, row := range table {
+		for j, o := range row {
+			e := rowTotals[i] * colTotals[j] / total
+			if e == 0 {
+				panic("stats: the contingency table has an empty row or column")
+			}
+			statistic += (o - e) * (o - e) / e
+		}
+	}
+	df := float64((r - 1) * (c - 1))
+	dist := pd.ChiSquared{K: df}
+	res := newTestResult("Chi-squared test of independence", "χ²", statistic, dist.Survival(statistic), alpha, TailRight)
+	res.DF = df
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	res.EffectSizeName = "V"
+	res.EffectSize = math.Sqrt(statistic / (total * float64(minInt(r, c)-1)))
+	return res
+}
+
+// minInt returns the smallest of two ints.
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// FTest performs a two sample F Test for the equality of variances.
+// The F score is the ratio of the sample variances var(a)/var(b), with len(a)-1 and len(b)-1 degrees of freedom.
+// The confidence interval is the one of the ratio of the population variances.
+// Right tail :
+// Null hypothesis Ho :  var(a) <= var(b).
+// Alternative hypothesis H1 : var(a) > var(b).
+// Two tails :
+// Null hypothesis Ho :  var(a) = var(b).
+// Alternative hypothesis H1 : var(a) != var(b).
+// Left tail :
+// Null hypothesis Ho :  var(a) >= var(b).
+// Alternative hypothesis H1 : var(a) < var(b).
+func FTest(a []float64, b []float64, alpha float64, tails TailDirection) TestResult {
+	if len(a) < 2 || len(b) < 2 {
+		panic("stats: incorrect samples length")
 	}
-	diffmean := Mean(diffsample)
-	diffs := StdDev(diffsample)
-	n := float64(len(presample))
-	tscore := diffmean / (diffs / math.Sqrt(n))
+	fscore := Variance(a) / Variance(b)
+	dist := pd.F{D1: float64(len(a) - 1), D2: float64(len(b) - 1)}
+	var pvalue float64
+	var ci pd.Interval
 	switch tails {
 	case TailRight:
-		tcritical := pd.GetTStatistic(n-1, alpha)
-		if tscore > tcritical {
-			return false, tcritical, tscore
-		}
-		return true, tcritical, tscore
+		pvalue = dist.Survival(fscore)
+		ci = pd.Interval{Min: fscore / dist.Quantile(1-alpha), Max: math.Inf(1)}
 	case TailBoth:
-		utcritical := pd.GetTStatistic(n-1, 2*alpha)
-		ltcritical := -utcritical
-		if tscore > utcritical || tscore < ltcritical {
-			return false, utcritical, tscore
-		}
-		return true, utcritical, tscore
+		pvalue = math.Min(1, 2*math.Min(dist.CDF(fscore), dist.Survival(fscore)))
+		ci = pd.Interval{Min: fscore / dist.Quantile(1-alpha/2), Max: fscore / dist.Quantile(alpha/2)}
 	case TailLeft:
-		tcritical := -pd.GetTStatistic(n-1, alpha)
-		if tscore < tcritical {
-			return false, tcritical, tscore
-		}
-		return true, tcritical, tscore
+		pvalue = dist.CDF(fscore)
+		ci = pd.Interval{Min: 0, Max: fscore / dist.Quantile(alpha)}
 	default:
-		panic("stats: incorrect tails input value. Try Try TailRight, TailLeft or TailBoth")
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+	res := newTestResult("F test of equal variances", "F", fscore, pvalue, alpha, tails)
+	res.DF = dist.D1
+	res.DF2 = dist.D2
+	res.CriticalValues = criticalValues(dist, alpha, tails)
+	res.ConfidenceInterval = ci
+	return res
+}
+
+// ANOVAResult holds the output of a one-way analysis of variance.
+// The embedded TestResult holds the F score with DF between groups and DF2 within groups,
+// and eta squared (SSBetween/(SSBetween+SSWithin)) as effect size.
+type ANOVAResult struct {
+	TestResult
+	// SSBetween is the sum of squares between groups.
+	SSBetween float64
+	// SSWithin is the sum of squares within groups.
+	SSWithin float64
+	// MSBetween is SSBetween/DF.
+	MSBetween float64
+	// MSWithin is SSWithin/DF2.
+	MSWithin float64
+}
+
+// OneWayANOVA performs a one-way analysis of variance on two or more independent groups.
+// Null hypothesis Ho : all the group means are equal.
+// Alternative hypothesis H1 : at least one group mean is different.
+func OneWayANOVA(alpha float64, groups ...[]float64) ANOVAResult {
+	if len(groups) < 2 {
+		panic("stats: at least two groups are needed")
+	}
+	n := 0
+	sum := 0.0
+	for _, g := range groups {
+		if len(g) == 0 {
+			panic("stats: incorrect samples length")
+		}
+		n += len(g)
+		for _, x := range g {
+			sum += x
+		}
+	}
+	if n <= len(groups) {
+		panic("stats: not enough observations for the number of groups")
+	}
+	grandMean := sum / float64(n)
+	var res ANOVAResult
+	for _, g := range groups {
+		mean := Mean(g)
+		res.SSBetween += float64(len(g)) * (mean - grandMean) * (mean - grandMean)
+		for _, x := range g {
+			res.SSWithin += (x - mean) * (x - mean)
+		}
 	}
+	dist := pd.F{D1: float64(len(groups) - 1), D2: float64(n - len(groups))}
+	res.MSBetween = res.SSBetween / dist.D1
+	res.MSWithin = res.SSWithin / dist.D2
+	fscore := res.MSBetween / res.MSWithin
+	res.TestResult = newTestResult("One-way ANOVA", "F", fscore, dist.Survival(fscore), alpha, TailRight)
+	res.DF = dist.D1
+	res.DF2 = dist.D2
+	res.CriticalValues = criticalValues(dist, alpha, TailRight)
+	res.EffectSizeName = "η²"
+	res.EffectSize = res.SSBetween / (res.SSBetween + res.SSWithin)
+	return res
 }
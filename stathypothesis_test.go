@@ -20,11 +20,17 @@ func TestOneSampleZTest(t *testing.T) {
 		want  bool
 		want1 float64
 	}{
-		{"Normal case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, pd.Normal{Mu: 0, Sigma: 1}, 0.05, 1}, true, 0.464639},
+		{"Left tail case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, pd.Normal{Mu: 0, Sigma: 1}, 0.05, TailLeft}, true, 0.535361},
+		{"Right tail case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, pd.Normal{Mu: 0, Sigma: 1}, 0.05, TailRight}, true, 0.464639},
+		{"Two tails case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, pd.Normal{Mu: 0, Sigma: 1}, 0.05, TailBoth}, true, 0.929278},
+		{"Shifted population case", args{[]float64{10.5, 11.2, 9.8, 12.1, 10.9, 11.4, 10.2, 11.8, 10.7}, pd.Normal{Mu: 10, Sigma: 2}, 0.05, TailBoth}, true, 0.151763},
+		{"Symmetric negative case", args{[]float64{9.5, 8.8, 10.2, 7.9, 9.1, 8.6, 9.8, 8.2, 9.3}, pd.Normal{Mu: 10, Sigma: 2}, 0.05, TailBoth}, true, 0.151763},
+		{"Significant case", args{[]float64{12.5, 13.2, 11.8, 14.1, 12.9, 13.4, 12.2, 13.8, 12.7}, pd.Normal{Mu: 10, Sigma: 2}, 0.05, TailBoth}, false, 0.0},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1 := OneSampleZTest(tt.args.sample, tt.args.pop, tt.args.alpha, tt.args.tails)
+			res := OneSampleZTest(tt.args.sample, tt.args.pop, tt.args.alpha, tt.args.tails)
+			got, got1 := res.Accepted, res.PValue
 			if got != tt.want {
 				t.Errorf("OneSampleZTest() got = %v, want %v", got, tt.want)
 			}
@@ -35,6 +41,34 @@ func TestOneSampleZTest(t *testing.T) {
 	}
 }
 
+func TestOneSampleZTest_Result(t *testing.T) {
+	sample := []float64{10.5, 11.2, 9.8, 12.1, 10.9, 11.4, 10.2, 11.8, 10.7}
+	pop := pd.Normal{Mu: 10, Sigma: 2}
+	tests := []struct {
+		name  string
+		tails TailDirection
+		ci    pd.Interval
+	}{
+		{"Two tails case", TailBoth, pd.Interval{Min: 9.648913, Max: 12.262198}},
+		{"Right tail case", TailRight, pd.Interval{Min: 9.858986, Max: math.Inf(1)}},
+		{"Left tail case", TailLeft, pd.Interval{Min: math.Inf(-1), Max: 12.052125}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := OneSampleZTest(sample, pop, 0.05, tt.tails)
+			if math.Abs(res.Statistic-1.433333) > 1e-6 || res.StatisticName != "z" {
+				t.Errorf("OneSampleZTest() Statistic = %v %v, want z 1.433333", res.StatisticName, res.Statistic)
+			}
+			if math.Abs(res.EffectSize-0.477778) > 1e-6 || res.EffectSizeName != "d" {
+				t.Errorf("OneSampleZTest() EffectSize = %v %v, want d 0.477778", res.EffectSizeName, res.EffectSize)
+			}
+			if !closeInterval(res.ConfidenceInterval, tt.ci, 1e-6) {
+				t.Errorf("OneSampleZTest() ConfidenceInterval = %v, want %v", res.ConfidenceInterval, tt.ci)
+			}
+		})
+	}
+}
+
 func TestOneSampleTTest(t *testing.T) {
 	type args struct {
 		sample  []float64
@@ -48,16 +82,19 @@ func TestOneSampleTTest(t *testing.T) {
 		want  bool
 		want1 float64
 	}{
-		{"Normal case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 0.0, 0.05, 0}, true, 1.7823},
-		{"Normal case true", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 10.0, 0.05, 0}, true, 1.7823},
-		{"Normal case false", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, -10.0, 0.05, 0}, false, 1.7823},
+		{"Normal case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 0.0, 0.05, 0}, true, 0.342359},
+		{"Normal case true", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 10.0, 0.05, 0}, true, 1.0},
+		{"Normal case false", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, -10.0, 0.05, 0}, false, 0.0},
+		{"Two tails case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 0.0, 0.05, TailBoth}, true, 0.684719},
+		{"Left tail case", args{[]float64{0.1, 0.02, -0.3, 0.47, 0.015, 0.21, -0.32, -0.05, -0.1, 0.15, 0.17, 0.08, -0.125}, 0.0, 0.05, TailLeft}, true, 0.657641},
 		{"Zero mean case", args{[]float64{0, 0, 0, 0, 0, 0,
 			0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 12.5, 0, 12.5, 12.5, 12.5, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 12.5, 0, 0, 0, 12.5, 0, 0, 12.5, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0,
-			0, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, 0.05, 0}, false, 1.662},
+			0, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, 0.05, 0}, false, 1.6024e-05},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1 := OneSampleTTest(tt.args.sample, tt.args.popmean, tt.args.alpha, tt.args.tails)
+			res := OneSampleTTest(tt.args.sample, tt.args.popmean, tt.args.alpha, tt.args.tails)
+			got, got1 := res.Accepted, res.PValue
 			if got != tt.want {
 				t.Errorf("OneSampleTTest() got = %v, want %v", got, tt.want)
 			}
@@ -85,13 +122,13 @@ func TestPairedTTest(t *testing.T) {
 			37.5, 37.5, 25, 12.5, 0, 12.5, 0, 0, 12.5, 12.5, 12.5, 0, 0, 12.5, 0, 12.5, 12.5, 12.5, 0, 25, 12.5, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 12.5, 37.5, 25, 12.5, 0, 12.5, 0, 12.5, 12.5, 12.5, 12.5, 0}, []float64{0, 0, 0, 0, 0, 0,
 			0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 12.5, 0, 12.5, 12.5, 12.5, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 12.5, 0, 0, 0, 12.5, 0, 0, 12.5, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0,
 			0, 0, 0, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 12.5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0.05, TailRight}, false},
-		{"Case H0 true", args{[]float64{25, 12}, []float64{12, 0}, 0.05, TailRight}, true},
+		{"Case H0 false small sample", args{[]float64{25, 12}, []float64{12, 0}, 0.05, TailRight}, false},
 		{"Case H0 true", args{[]float64{25, 12}, []float64{0, 0}, 0.05, TailRight}, true},
 		{"Case H0 false", args{[]float64{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80}, []float64{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12}, 0.05, TailRight}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _, _ := PairedTTest(tt.args.presample, tt.args.postsample, tt.args.alpha, tt.args.tails)
+			got := PairedTTest(tt.args.presample, tt.args.postsample, tt.args.alpha, tt.args.tails).Accepted
 			if got != tt.want {
 				t.Errorf("PairedTTest() got = %v, want %v", got, tt.want)
 			}
@@ -101,3 +138,400 @@ func TestPairedTTest(t *testing.T) {
 		})
 	}
 }
+
+func TestPoissonRateTest(t *testing.T) {
+	type args struct {
+		count1    float64
+		exposure1 float64
+		count2    float64
+		exposure2 float64
+		alpha     float64
+		tails     TailDirection
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      bool
+		want1     float64
+		wantPanic bool
+	}{
+		{"Equal rates case", args{10, 1, 10, 1, 0.05, TailBoth}, true, 1.0, false},
+		{"Symmetric two tails case", args{7, 1, 13, 1, 0.05, TailBoth}, true, 0.263176, false},
+		{"Symmetric left tail case", args{7, 1, 13, 1, 0.05, TailLeft}, true, 0.131588, false},
+		{"Symmetric right tail case", args{7, 1, 13, 1, 0.05, TailRight}, true, 0.942341, false},
+		{"Jump right tail case", args{10, 1, 5, 2, 0.05, TailRight}, false, 0.008504, false},
+		{"Jump two tails case", args{10, 1, 5, 2, 0.05, TailBoth}, false, 0.010788, false},
+		{"Drop left tail case", args{1, 1, 14, 2, 0.05, TailLeft}, false, 0.019411, false},
+		{"Invalid exposure case", args{1, 0, 14, 2, 0.05, TailLeft}, true, 0.0, true},
+		{"Invalid count case", args{1.5, 1, 14, 2, 0.05, TailLeft}, true, 0.0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if !tt.wantPanic {
+						t.Error("PoissonRateTest() want panic")
+					}
+				}
+			}()
+			res := PoissonRateTest(tt.args.count1, tt.args.exposure1, tt.args.count2, tt.args.exposure2, tt.args.alpha, tt.args.tails)
+			got, got1 := res.Accepted, res.PValue
+			if tt.wantPanic {
+				t.Error("PoissonRateTest() did not panic")
+			}
+			if got != tt.want {
+				t.Errorf("PoissonRateTest() got = %v, want %v", got, tt.want)
+			}
+			if math.Abs(got1-tt.want1) > 1e-6 {
+				t.Errorf("PoissonRateTest() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestChiSquareGoodnessOfFit(t *testing.T) {
+	type args struct {
+		observed []float64
+		expected []float64
+		alpha    float64
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      float64
+		want1     float64
+		want2     float64
+		wantPanic bool
+	}{
+		{"Uniform case", args{[]float64{16, 18, 16, 14, 12, 12}, []float64{1, 1, 1, 1, 1, 1}, 0.05}, 2.0, 5, 0.849145, false},
+		{"Expected counts case", args{[]float64{16, 18, 16, 14, 12, 12}, []float64{16, 16, 16, 16, 16, 8}, 0.05}, 3.5, 5, 0.623388, false},
+		{"Perfect fit case", args{[]float64{10, 20, 30}, []float64{10, 20, 30}, 0.05}, 0.0, 2, 1.0, false},
+		{"Length mismatch case", args{[]float64{10, 20, 30}, []float64{10, 20}, 0.05}, 0.0, 0, 0.0, true},
+		{"Zero expected case", args{[]float64{10, 20}, []float64{10, 0}, 0.05}, 0.0, 0, 0.0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if !tt.wantPanic {
+						t.Error("ChiSquareGoodnessOfFit() want panic")
+					}
+				}
+			}()
+			res := ChiSquareGoodnessOfFit(tt.args.observed, tt.args.expected, tt.args.alpha)
+			got, got1, got2 := res.Statistic, res.DF, res.PValue
+			if tt.wantPanic {
+				t.Error("ChiSquareGoodnessOfFit() did not panic")
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ChiSquareGoodnessOfFit() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("ChiSquareGoodnessOfFit() got1 = %v, want %v", got1, tt.want1)
+			}
+			if math.Abs(got2-tt.want2) > 1e-6 {
+				t.Errorf("ChiSquareGoodnessOfFit() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestChiSquareIndependence(t *testing.T) {
+	type args struct {
+		table [][]float64
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      float64
+		want1     float64
+		want2     float64
+		wantPanic bool
+	}{
+		{"2x3 case", args{[][]float64{{10, 10, 20}, {20, 20, 20}}}, 2.777778, 2, 0.249352, false},
+		{"Independent case", args{[][]float64{{10, 20}, {30, 60}}}, 0.0, 1, 1.0, false},
+		{"Dependent case", args{[][]float64{{50, 10}, {10, 50}}}, 53.333333, 1, 2.8e-13, false},
+		{"Ragged case", args{[][]float64{{10, 20}, {30}}}, 0.0, 0, 0.0, true},
+		{"Empty column case", args{[][]float64{{10, 0}, {30, 0}}}, 0.0, 0, 0.0, true},
+		{"Too small case", args{[][]float64{{10, 20}}}, 0.0, 0, 0.0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if !tt.wantPanic {
+						t.Error("ChiSquareIndependence() want panic")
+					}
+				}
+			}()
+			res := ChiSquareIndependence(tt.args.table, 0.05)
+			got, got1, got2 := res.Statistic, res.DF, res.PValue
+			if tt.wantPanic {
+				t.Error("ChiSquareIndependence() did not panic")
+			}
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("ChiSquareIndependence() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("ChiSquareIndependence() got1 = %v, want %v", got1, tt.want1)
+			}
+			if math.Abs(got2-tt.want2) > 1e-6 {
+				t.Errorf("ChiSquareIndependence() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestFTest(t *testing.T) {
+	type args struct {
+		a     []float64
+		b     []float64
+		alpha float64
+		tails TailDirection
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      bool
+		want1     float64
+		want2     float64
+		wantPanic bool
+	}{
+		{"Left tail case", args{[]float64{1, 2, 3}, []float64{2, 4, 6, 8, 10}, 0.05, TailLeft}, true, 0.092971, 0.1, false},
+		{"Right tail case", args{[]float64{1, 2, 3}, []float64{2, 4, 6, 8, 10}, 0.05, TailRight}, true, 0.907029, 0.1, false},
+		{"Two tails case", args{[]float64{1, 2, 3}, []float64{2, 4, 6, 8, 10}, 0.05, TailBoth}, true, 0.185941, 0.1, false},
+		{"Different variances case", args{[]float64{2, 4, 6, 8, 10, 20, -8, 30, -15}, []float64{1, 1.5, 2, 1.2, 1.8, 1.1, 1.9, 1.4}, 0.05, TailBoth}, false, 0.0, 1256.009913, false},
+		{"Short sample case", args{[]float64{1}, []float64{2, 4, 6, 8, 10}, 0.05, TailBoth}, true, 0.0, 0.0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if !tt.wantPanic {
+						t.Error("FTest() want panic")
+					}
+				}
+			}()
+			res := FTest(tt.args.a, tt.args.b, tt.args.alpha, tt.args.tails)
+			got, got1, got2 := res.Accepted, res.PValue, res.Statistic
+			if tt.wantPanic {
+				t.Error("FTest() did not panic")
+			}
+			if got != tt.want {
+				t.Errorf("FTest() got = %v, want %v", got, tt.want)
+			}
+			if math.Abs(got1-tt.want1) > 1e-6 {
+				t.Errorf("FTest() got1 = %v, want %v", got1, tt.want1)
+			}
+			if math.Abs(got2-tt.want2) > 1e-2 {
+				t.Errorf("FTest() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestOneWayANOVA(t *testing.T) {
+	tests := []struct {
+		name      string
+		groups    [][]float64
+		want      ANOVAResult
+		wantPanic bool
+	}{
+		{"Three regions case", [][]float64{{6, 8, 4, 5, 3, 4}, {8, 12, 9, 11, 6, 8}, {13, 9, 11, 8, 7, 12}},
+			ANOVAResult{TestResult{Statistic: 9.264706, DF: 2, DF2: 15, PValue: 0.002398, EffectSize: 0.552632}, 84, 68, 42, 4.533333}, false},
+		{"Equal means case", [][]float64{{1, 2, 3}, {3, 2, 1}},
+			ANOVAResult{TestResult{Statistic: 0, DF: 1, DF2: 4, PValue: 1, EffectSize: 0}, 0, 4, 0, 1}, false},
+		{"Single group case", [][]float64{{1, 2, 3}}, ANOVAResult{}, true},
+		{"Empty group case", [][]float64{{1, 2, 3}, {}}, ANOVAResult{}, true},
+		{"No within df case", [][]float64{{1}, {2}}, ANOVAResult{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if !tt.wantPanic {
+						t.Error("OneWayANOVA() want panic")
+					}
+				}
+			}()
+			got := OneWayANOVA(0.05, tt.groups...)
+			if tt.wantPanic {
+				t.Error("OneWayANOVA() did not panic")
+			}
+			fields := []struct {
+				name      string
+				got, want float64
+			}{
+				{"SSBetween", got.SSBetween, tt.want.SSBetween},
+				{"SSWithin", got.SSWithin, tt.want.SSWithin},
+				{"MSBetween", got.MSBetween, tt.want.MSBetween},
+				{"MSWithin", got.MSWithin, tt.want.MSWithin},
+				{"Statistic", got.Statistic, tt.want.Statistic},
+				{"DF", got.DF, tt.want.DF},
+				{"DF2", got.DF2, tt.want.DF2},
+				{"PValue", got.PValue, tt.want.PValue},
+				{"EffectSize", got.EffectSize, tt.want.EffectSize},
+			}
+			for _, f := range fields {
+				if math.Abs(f.got-f.want) > 1e-6 {
+					t.Errorf("OneWayANOVA() %s = %v, want %v", f.name, f.got, f.want)
+				}
+			}
+		})
+	}
+}
+
+func TestOneSampleTTest_Result(t *testing.T) {
+	sample := []float64{1, 2, 3, 4, 5}
+	tests := []struct {
+		name     string
+		tails    TailDirection
+		ci       pd.Interval
+		critical []float64
+		accepted bool
+	}{
+		{"Two tails case", TailBoth, pd.Interval{Min: 1.036757, Max: 4.963243}, []float64{-2.776445, 2.776445}, false},
+		{"Right tail case", TailRight, pd.Interval{Min: 1.492557, Max: math.Inf(1)}, []float64{2.131847}, false},
+		{"Left tail case", TailLeft, pd.Interval{Min: math.Inf(-1), Max: 4.507443}, []float64{-2.131847}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := OneSampleTTest(sample, 0, 0.05, tt.tails)
+			if math.Abs(res.Statistic-4.242641) > 1e-6 {
+				t.Errorf("OneSampleTTest() Statistic = %v, want %v", res.Statistic, 4.242641)
+			}
+			if res.DF != 4 || !math.IsNaN(res.DF2) {
+				t.Errorf("OneSampleTTest() DF = %v, DF2 = %v, want 4, NaN", res.DF, res.DF2)
+			}
+			if math.Abs(res.EffectSize-1.897367) > 1e-6 || res.EffectSizeName != "d" {
+				t.Errorf("OneSampleTTest() EffectSize = %v %v, want d 1.897367", res.EffectSizeName, res.EffectSize)
+			}
+			if !closeInterval(res.ConfidenceInterval, tt.ci, 1e-6) {
+				t.Errorf("OneSampleTTest() ConfidenceInterval = %v, want %v", res.ConfidenceInterval, tt.ci)
+			}
+			if len(res.CriticalValues) != len(tt.critical) {
+				t.Fatalf("OneSampleTTest() CriticalValues = %v, want %v", res.CriticalValues, tt.critical)
+			}
+			for i := range tt.critical {
+				if math.Abs(res.CriticalValues[i]-tt.critical[i]) > 1e-6 {
+					t.Errorf("OneSampleTTest() CriticalValues = %v, want %v", res.CriticalValues, tt.critical)
+				}
+			}
+			if res.Alternative != tt.tails || res.Alpha != 0.05 || res.Accepted != tt.accepted {
+				t.Errorf("OneSampleTTest() = %+v", res)
+			}
+		})
+	}
+}
+
+func TestPairedTTest_Result(t *testing.T) {
+	res := PairedTTest([]float64{3, 4, 5, 6, 7}, []float64{2, 2, 2, 2, 2}, 0.05, TailBoth)
+	want := OneSampleTTest([]float64{1, 2, 3, 4, 5}, 0, 0.05, TailBoth)
+	if res.Statistic != want.Statistic || res.PValue != want.PValue || res.ConfidenceInterval != want.ConfidenceInterval || res.EffectSize != want.EffectSize {
+		t.Errorf("PairedTTest() = %+v, want %+v", res, want)
+	}
+	if res.Name != "Paired t test" {
+		t.Errorf("PairedTTest() Name = %v", res.Name)
+	}
+}
+
+func TestTwoSampleTTest(t *testing.T) {
+	// Student's sleep data, the reference values are the ones of R t.test.
+	a := []float64{0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0}
+	b := []float64{1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4}
+	tests := []struct {
+		name     string
+		equalVar bool
+		tails    TailDirection
+		df       float64
+		pvalue   float64
+		ci       pd.Interval
+		accepted bool
+	}{
+		{"Welch two tails case", false, TailBoth, 17.776474, 0.079394, pd.Interval{Min: -3.365483, Max: 0.205483}, true},
+		{"Welch left tail case", false, TailLeft, 17.776474, 0.039697, pd.Interval{Min: math.Inf(-1), Max: -0.106619}, false},
+		{"Welch right tail case", false, TailRight, 17.776474, 0.960303, pd.Interval{Min: -3.053381, Max: math.Inf(1)}, true},
+		{"Pooled two tails case", true, TailBoth, 18, 0.079187, pd.Interval{Min: -3.363874, Max: 0.203874}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := TwoSampleTTest(a, b, tt.equalVar, 0.05, tt.tails)
+			if math.Abs(res.Statistic+1.860813) > 1e-6 {
+				t.Errorf("TwoSampleTTest() Statistic = %v, want %v", res.Statistic, -1.860813)
+			}
+			if math.Abs(res.DF-tt.df) > 1e-6 {
+				t.Errorf("TwoSampleTTest() DF = %v, want %v", res.DF, tt.df)
+			}
+			if math.Abs(res.PValue-tt.pvalue) > 1e-6 {
+				t.Errorf("TwoSampleTTest() PValue = %v, want %v", res.PValue, tt.pvalue)
+			}
+			if !closeInterval(res.ConfidenceInterval, tt.ci, 1e-6) {
+				t.Errorf("TwoSampleTTest() ConfidenceInterval = %v, want %v", res.ConfidenceInterval, tt.ci)
+			}
+			if math.Abs(res.EffectSize+0.832181) > 1e-6 || res.EffectSizeName != "d" {
+				t.Errorf("TwoSampleTTest() EffectSize = %v %v, want d -0.832181", res.EffectSizeName, res.EffectSize)
+			}
+			if res.Accepted != tt.accepted {
+				t.Errorf("TwoSampleTTest() Accepted = %v, want %v", res.Accepted, tt.accepted)
+			}
+		})
+	}
+}
+
+func TestPoissonRateTest_Result(t *testing.T) {
+	res := PoissonRateTest(7, 1, 13, 1, 0.05, TailBoth)
+	if math.Abs(res.EffectSize-7.0/13) > 1e-12 || res.EffectSizeName != "RR" {
+		t.Errorf("PoissonRateTest() EffectSize = %v %v, want RR %v", res.EffectSizeName, res.EffectSize, 7.0/13)
+	}
+	// The interval bounds are the rate ratios whose two sided exact p-values are alpha/2 in each tail.
+	lo, hi := res.ConfidenceInterval.Min, res.ConfidenceInterval.Max
+	if !(lo < res.EffectSize && res.EffectSize < hi) {
+		t.Fatalf("PoissonRateTest() ConfidenceInterval = %v does not contain %v", res.ConfidenceInterval, res.EffectSize)
+	}
+	plo, phi := lo/(1+lo), hi/(1+hi)
+	if got := (pd.Binomial{N: 20, P: plo}).Survival(6); math.Abs(got-0.025) > 1e-9 {
+		t.Errorf("P(X >= 7 | lower bound) = %v, want 0.025", got)
+	}
+	if got := (pd.Binomial{N: 20, P: phi}).CDF(7); math.Abs(got-0.025) > 1e-9 {
+		t.Errorf("P(X <= 7 | upper bound) = %v, want 0.025", got)
+	}
+	right := PoissonRateTest(0, 1, 13, 1, 0.05, TailRight)
+	if right.ConfidenceInterval.Min != 0 || !math.IsInf(right.ConfidenceInterval.Max, 1) {
+		t.Errorf("PoissonRateTest() ConfidenceInterval = %v, want [0, Inf]", right.ConfidenceInterval)
+	}
+}
+
+func TestChiSquareIndependence_Result(t *testing.T) {
+	res := ChiSquareIndependence([][]float64{{50, 10}, {10, 50}}, 0.05)
+	if math.Abs(res.EffectSize-math.Sqrt(53.333333/120)) > 1e-6 || res.EffectSizeName != "V" {
+		t.Errorf("ChiSquareIndependence() EffectSize = %v %v", res.EffectSizeName, res.EffectSize)
+	}
+	if len(res.CriticalValues) != 1 || math.Abs(res.CriticalValues[0]-3.841459) > 1e-6 {
+		t.Errorf("ChiSquareIndependence() CriticalValues = %v, want [3.841459]", res.CriticalValues)
+	}
+	if res.Accepted || res.Alternative != TailRight {
+		t.Errorf("ChiSquareIndependence() = %+v", res)
+	}
+}
+
+func TestFTest_Result(t *testing.T) {
+	res := FTest([]float64{1, 2, 3}, []float64{2, 4, 6, 8, 10}, 0.05, TailBoth)
+	if res.DF != 2 || res.DF2 != 4 {
+		t.Errorf("FTest() DF = %v, DF2 = %v, want 2, 4", res.DF, res.DF2)
+	}
+	// The ratio of variances 1 is inside the interval since the test accepts equal variances.
+	ci := res.ConfidenceInterval
+	if !(ci.Min < 1 && 1 < ci.Max) || !(ci.Min < res.Statistic && res.Statistic < ci.Max) {
+		t.Errorf("FTest() ConfidenceInterval = %v", ci)
+	}
+	if want := res.Statistic / (pd.F{D1: 2, D2: 4}).Quantile(0.975); math.Abs(ci.Min-want) > 1e-12 {
+		t.Errorf("FTest() ConfidenceInterval.Min = %v, want %v", ci.Min, want)
+	}
+}
+
+func closeInterval(a pd.Interval, b pd.Interval, tol float64) bool {
+	closeTo := func(x, y float64) bool {
+		return x == y || math.Abs(x-y) <= tol
+	}
+	return closeTo(a.Min, b.Min) && closeTo(a.Max, b.Max)
+}
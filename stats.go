@@ -1,9 +1,6 @@
 package stats
 
-import (
-	"math"
-	"sort"
-)
+import "math"
 
 // Mean returns the mean of the slice.
 func Mean(input []float64) float64 {
@@ -14,25 +11,15 @@ func Mean(input []float64) float64 {
 	return sum / float64(len(input))
 }
 
-// Median returns the median of the slice. Panics if the input is not sorted.
-func Median(input []float64) (output float64) {
-	if len(input) < 2 {
-		return math.NaN()
-	}
-
-	if !sort.Float64sAreSorted(input) {
-		panic("stats: input is not sorted.")
-	}
-
-	l := len(input)
-
-	if l%2 != 0 {
-		output = input[l/2]
-	} else {
-		output = (input[l/2] + input[l/2-1]) / 2
-	}
+// Median returns the median of the slice, or NaN if it is empty.
+// The input does not need to be sorted and is not modified, the median is found by selection in a copy.
+func Median(input []float64) float64 {
+	return Quantile(input, 0.5, QuantileType7)
+}
 
-	return output
+// MedianInPlace is like Median but avoids the copy of the input by reordering it.
+func MedianInPlace(input []float64) float64 {
+	return QuantileInPlace(input, 0.5, QuantileType7)
 }
 
 // Max returns the maximum value of the sample.
@@ -100,46 +87,26 @@ func Variance(input []float64) float64 {
 	return sumOfSquaredDifferences(input) / float64(len(input)-1)
 }
 
-// Quartile1 returns the first quartile.
-func Quartile1(input []float64) (Q1 float64) {
-	if len(input) < 4 {
-		return math.NaN()
-	}
-	if !sort.Float64sAreSorted(input) {
-		panic("stats: input is not sorted.")
-	}
-	if len(input)%2 == 0 {
-		Q1 = Median(input[:len(input)/2])
-	} else {
-		Q1 = Median(input[:len(input)/2])
-	}
-	return Q1
+// Quartile1 returns the first quartile, the 0.25 quantile with the default method of Quantile.
+func Quartile1(input []float64) float64 {
+	return Quantile(input, 0.25, QuantileDefault)
 }
 
-// Quartile2 returns the second quartile (equivalent to the median).
+// Quartile2 returns the second quartile, the 0.5 quantile with the default method of Quantile
+// (equivalent to the median).
 func Quartile2(input []float64) float64 {
-	return Median(input)
+	return Quantile(input, 0.5, QuantileDefault)
 }
 
-// Quartile3 returns the third quartile.
-func Quartile3(input []float64) (Q3 float64) {
-	if len(input) < 4 {
-		return math.NaN()
-	}
-	if !sort.Float64sAreSorted(input) {
-		panic("stats: input is not sorted.")
-	}
-	if len(input)%2 == 0 {
-		Q3 = Median(input[len(input)/2:])
-	} else {
-		Q3 = Median(input[len(input)/2+1:])
-	}
-	return Q3
+// Quartile3 returns the third quartile, the 0.75 quantile with the default method of Quantile.
+func Quartile3(input []float64) float64 {
+	return Quantile(input, 0.75, QuantileDefault)
 }
 
 // InterQuartileRange returns the difference between the third and the first quartiles.
 func InterQuartileRange(input []float64) float64 {
-	return Quartile3(input) - Quartile1(input)
+	q := Quantiles(input, []float64{0.25, 0.75}, QuantileDefault)
+	return q[1] - q[0]
 }
 
 // Covariance returns the covariance between two data samples
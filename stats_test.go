@@ -65,10 +65,10 @@ func TestMedian(t *testing.T) {
 	}{
 		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 2.5, false},
 		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 3.0, false},
-		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 2.5, true},
-		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 3.0, true},
+		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 2.5, false},
+		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 3.0, false},
 		{"empty case", args{[]float64{}}, math.NaN(), false},
-		{"single value case", args{[]float64{1.0}}, math.NaN(), false},
+		{"single value case", args{[]float64{1.0}}, 1.0, false},
 		{"two values case", args{[]float64{1.0, 9.0}}, 5.0, false},
 		{"nan", args{[]float64{math.NaN(), 5.0}}, math.NaN(), false},
 	}
@@ -352,14 +352,14 @@ func TestQuartile1(t *testing.T) {
 		want      float64
 		wantPanic bool
 	}{
-		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 1.5, false},
-		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 1.5, false},
-		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 1.5, true},
-		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 1.5, true},
+		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 1.75, false},
+		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 2.0, false},
+		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 1.75, false},
+		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 2.0, false},
 		{"empty case", args{[]float64{}}, math.NaN(), false},
-		{"single value case", args{[]float64{1.0}}, math.NaN(), false},
-		{"two values case", args{[]float64{1.0, 9.0}}, math.NaN(), false},
-		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, math.NaN(), false},
+		{"single value case", args{[]float64{1.0}}, 1.0, false},
+		{"two values case", args{[]float64{1.0, 9.0}}, 3.0, false},
+		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, 5.0, false},
 		{"nan", args{[]float64{math.NaN(), 5.0}}, math.NaN(), false},
 	}
 	for _, tt := range tests {
@@ -367,7 +367,7 @@ func TestQuartile1(t *testing.T) {
 			defer func() {
 				if r := recover(); r != nil {
 					if !tt.wantPanic {
-						t.Error("Quartile2() want panic")
+						t.Error("Quartile1() want panic")
 					}
 				}
 			}()
@@ -414,10 +414,10 @@ func TestQuartile2(t *testing.T) {
 	}{
 		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 2.5, false},
 		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 3.0, false},
-		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 2.5, true},
-		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 3.0, true},
+		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 2.5, false},
+		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 3.0, false},
 		{"empty case", args{[]float64{}}, math.NaN(), false},
-		{"single value case", args{[]float64{1.0}}, math.NaN(), false},
+		{"single value case", args{[]float64{1.0}}, 1.0, false},
 		{"two values case", args{[]float64{1.0, 9.0}}, 5.0, false},
 		{"nan", args{[]float64{math.NaN(), 5.0}}, math.NaN(), false},
 	}
@@ -471,14 +471,14 @@ func TestQuartile3(t *testing.T) {
 		want      float64
 		wantPanic bool
 	}{
-		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 3.5, false},
-		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 4.5, false},
-		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 3.5, true},
-		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 4.5, true},
+		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 3.25, false},
+		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 4.0, false},
+		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 3.25, false},
+		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 4.0, false},
 		{"empty case", args{[]float64{}}, math.NaN(), false},
-		{"single value case", args{[]float64{1.0}}, math.NaN(), false},
-		{"two values case", args{[]float64{1.0, 9.0}}, math.NaN(), false},
-		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, math.NaN(), false},
+		{"single value case", args{[]float64{1.0}}, 1.0, false},
+		{"two values case", args{[]float64{1.0, 9.0}}, 7.0, false},
+		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, 10.0, false},
 		{"nan", args{[]float64{math.NaN(), 5.0}}, math.NaN(), false},
 	}
 	for _, tt := range tests {
@@ -531,14 +531,14 @@ func TestInterQuartileRange(t *testing.T) {
 		want      float64
 		wantPanic bool
 	}{
-		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 2.0, false},
-		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 3.0, false},
-		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 2.0, true},
-		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 3.0, true},
+		{"even case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0}}, 1.5, false},
+		{"odd case sorted", args{[]float64{1.0, 2.0, 3.0, 4.0, 5.0}}, 2.0, false},
+		{"even case unsorted", args{[]float64{4.0, 2.0, 1.0, 3.0}}, 1.5, false},
+		{"odd case unsorted", args{[]float64{4.0, 3.0, 5.0, 1.0, 2.0}}, 2.0, false},
 		{"empty case", args{[]float64{}}, math.NaN(), false},
-		{"single value case", args{[]float64{1.0}}, math.NaN(), false},
-		{"two values case", args{[]float64{1.0, 9.0}}, math.NaN(), false},
-		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, math.NaN(), false},
+		{"single value case", args{[]float64{1.0}}, 0.0, false},
+		{"two values case", args{[]float64{1.0, 9.0}}, 4.0, false},
+		{"three values case", args{[]float64{1.0, 9.0, 11.0}}, 5.0, false},
 		{"nan", args{[]float64{math.NaN(), 5.0}}, math.NaN(), false},
 	}
 	for _, tt := range tests {
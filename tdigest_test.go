@@ -0,0 +1,231 @@
+package stats
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// tdigestSamples returns n values of each of the distributions the t-digest error is documented for.
+func tdigestSamples(seed int64, n int) map[string][]float64 {
+	rnd := rand.New(rand.NewSource(seed))
+	samples := map[string][]float64{"uniform": make([]float64, n), "exponential": make([]float64, n), "cubed normal": make([]float64, n)}
+	for i := 0; i < n; i++ {
+		samples["uniform"][i] = rnd.Float64()
+		samples["exponential"][i] = rnd.ExpFloat64()
+		z := rnd.NormFloat64()
+		samples["cubed normal"][i] = z * z * z
+	}
+	return samples
+}
+
+func TestTDigest_Quantile(t *testing.T) {
+	tests := []struct {
+		p float64
+		// tol is the largest accepted rank error.
+		tol float64
+	}{
+		{0.001, 5e-4},
+		{0.01, 5e-4},
+		{0.99, 5e-4},
+		{0.999, 3e-4},
+		{0.9999, 1e-4},
+	}
+	for name, sample := range tdigestSamples(1, 100000) {
+		whole, _ := NewTDigest(100)
+		parts := make([]*TDigest, 8)
+		for i := range parts {
+			parts[i], _ = NewTDigest(100)
+		}
+		for i, x := range sample {
+			whole.Add(x)
+			parts[i%len(parts)].Add(x)
+		}
+		merged, _ := NewTDigest(100)
+		for _, part := range parts {
+			merged.Merge(part)
+		}
+
+		for digestName, digest := range map[string]*TDigest{"whole": whole, "merged": merged} {
+			if digest.Count() != len(sample) {
+				t.Errorf("%v %v Count() = %v, want %v", name, digestName, digest.Count(), len(sample))
+			}
+			for _, tt := range tests {
+				// The estimate must be between the exact quantiles tol below and above p.
+				got := digest.Quantile(tt.p)
+				bounds := Quantiles(sample, []float64{tt.p - tt.tol, tt.p + tt.tol}, QuantileType1)
+				if got < bounds[0] || got > bounds[1] {
+					t.Errorf("%v %v Quantile(%v) = %v, want in [%v, %v]", name, digestName, tt.p, got, bounds[0], bounds[1])
+				}
+			}
+		}
+	}
+}
+
+func TestTDigest_CDF(t *testing.T) {
+	sample := tdigestSamples(2, 10000)["exponential"]
+	digest, _ := NewTDigest(100)
+	for _, x := range sample {
+		digest.Add(x)
+	}
+	sorted := sortedCopy(sample)
+	for _, p := range []float64{0.001, 0.1, 0.5, 0.9, 0.999} {
+		x := Quantile(sample, p, QuantileDefault)
+		if got := digest.CDF(x); math.Abs(got-p) > 5e-3 {
+			t.Errorf("CDF(%v) = %v, want %v", x, got, p)
+		}
+	}
+	if got := digest.CDF(sorted[0] - 1); got != 0 {
+		t.Errorf("CDF() below the minimum = %v, want 0", got)
+	}
+	if got := digest.CDF(sorted[len(sorted)-1]); got != 1 {
+		t.Errorf("CDF() at the maximum = %v, want 1", got)
+	}
+	if got := digest.Quantile(0); got != sorted[0] {
+		t.Errorf("Quantile(0) = %v, want the minimum %v", got, sorted[0])
+	}
+	if got := digest.Quantile(1); got != sorted[len(sorted)-1] {
+		t.Errorf("Quantile(1) = %v, want the maximum %v", got, sorted[len(sorted)-1])
+	}
+}
+
+func TestTDigest_Small(t *testing.T) {
+	// Few values stay in singleton centroids, and the quantiles interpolate between them.
+	digest, _ := NewTDigest(100)
+	for _, x := range []float64{5, 1, 4, 2, 3} {
+		digest.Add(x)
+	}
+	digest.Add(math.NaN())
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{0.1, 1},
+		{0.5, 3},
+		{0.6, 3.5},
+		{1, 5},
+	}
+	for _, tt := range tests {
+		if got := digest.Quantile(tt.p); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Quantile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := digest.Count(); got != 5 {
+		t.Errorf("Count() = %v, want 5", got)
+	}
+	if got := digest.CDF(3); got != 0.5 {
+		t.Errorf("CDF(3) = %v, want 0.5", got)
+	}
+}
+
+func TestTDigest_Empty(t *testing.T) {
+	digest, _ := NewTDigest(100)
+	if got := digest.Quantile(0.5); !math.IsNaN(got) {
+		t.Errorf("empty Quantile() = %v, want NaN", got)
+	}
+	if got := digest.CDF(0); !math.IsNaN(got) {
+		t.Errorf("empty CDF() = %v, want NaN", got)
+	}
+	digest.Add(1)
+	for _, p := range []float64{-0.1, 1.1, math.NaN()} {
+		if got := digest.Quantile(p); !math.IsNaN(got) {
+			t.Errorf("Quantile(%v) = %v, want NaN", p, got)
+		}
+	}
+	for _, compression := range []float64{0, 5, math.NaN(), math.Inf(1)} {
+		if _, err := NewTDigest(compression); err == nil {
+			t.Errorf("NewTDigest(%v) should fail", compression)
+		}
+	}
+}
+
+func TestTDigest_ReadAllocations(t *testing.T) {
+	digest, _ := NewTDigest(100)
+	for _, x := range tdigestSamples(4, 10000)["exponential"] {
+		digest.Add(x)
+	}
+	// Once the buffer is merged, reading a quantile per scrape costs no allocation.
+	digest.Quantile(0.5)
+	if allocs := testing.AllocsPerRun(100, func() { digest.Quantile(0.99) }); allocs != 0 {
+		t.Errorf("Quantile() allocates %v times, want 0", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { digest.CDF(1) }); allocs != 0 {
+		t.Errorf("CDF() allocates %v times, want 0", allocs)
+	}
+}
+
+func TestTDigest_Binary(t *testing.T) {
+	sample := tdigestSamples(3, 10000)["cubed normal"]
+	digest, _ := NewTDigest(50)
+	for _, x := range sample {
+		digest.Add(x)
+	}
+	data, err := digest.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	var decoded TDigest
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if decoded.Count() != digest.Count() {
+		t.Errorf("decoded Count() = %v, want %v", decoded.Count(), digest.Count())
+	}
+	for _, p := range []float64{0, 0.01, 0.5, 0.99, 1} {
+		if got, want := decoded.Quantile(p), digest.Quantile(p); got != want {
+			t.Errorf("decoded Quantile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	// The decoded digest keeps its compression and can go on.
+	decoded.Merge(digest)
+	if decoded.Count() != 2*len(sample) {
+		t.Errorf("merged Count() = %v, want %v", decoded.Count(), 2*len(sample))
+	}
+
+	for _, bad := range [][]byte{nil, data[:10], data[:len(data)-1], append(append([]byte(nil), data...), 0), append([]byte{9}, data[1:]...)} {
+		if err := new(TDigest).UnmarshalBinary(bad); err == nil {
+			t.Errorf("UnmarshalBinary() of %d bytes should fail", len(bad))
+		}
+	}
+}
+
+func TestTDigest_BinaryCorrupt(t *testing.T) {
+	digest, _ := NewTDigest(100)
+	for _, x := range []float64{1, 2, 3} {
+		digest.Add(x)
+	}
+	data, _ := digest.MarshalBinary()
+	empty, _ := NewTDigest(100)
+	emptyData, _ := empty.MarshalBinary()
+	// corrupt returns a copy of data with the float64 at offset set to v.
+	corrupt := func(data []byte, offset int, v float64) []byte {
+		c := append([]byte(nil), data...)
+		binary.LittleEndian.PutUint64(c[offset:], math.Float64bits(v))
+		return c
+	}
+	// The minimum and maximum are at offsets 9 and 17, the three centroids of weight 1 start at 26, 9 bytes apart.
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"Max below min case", corrupt(data, 17, 0.5)},
+		{"NaN min case", corrupt(data, 9, math.NaN())},
+		{"Mean below min case", corrupt(data, 26, 0)},
+		{"Mean above max case", corrupt(data, 44, 4)},
+		{"Unsorted means case", corrupt(data, 26, 2.5)},
+		{"Infinite compression case", corrupt(data, 1, math.Inf(1))},
+		{"Empty with extremes case", corrupt(emptyData, 9, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := new(TDigest).UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary() should fail")
+			}
+		})
+	}
+	if err := new(TDigest).UnmarshalBinary(emptyData); err != nil {
+		t.Errorf("UnmarshalBinary() of an empty digest error = %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+// TestResult holds the outcome of a statistical hypothesis test.
+// Fields that do not apply to a given test are set to NaN (or left empty for slices and names).
+type TestResult struct {
+	// Name is the name of the test, e.g. "One sample t test".
+	Name string
+	// StatisticName is the symbol of the test statistic, e.g. "t", "z", "F" or "χ²".
+	StatisticName string
+	// Statistic is the value of the test statistic.
+	Statistic float64
+	// DF is the number of degrees of freedom of the statistic. It may be fractional (Welch).
+	DF float64
+	// DF2 is the second number of degrees of freedom for statistics that have two, such as F.
+	DF2 float64
+	// PValue is the probability, under the null hypothesis, of a statistic at least as extreme as the observed one.
+	PValue float64
+	// Alpha is the significance level used to take the decision.
+	Alpha float64
+	// CriticalValues are the bounds of the rejection region: one value for one tail and two for both tails.
+	CriticalValues []float64
+	// ConfidenceInterval is the 1-Alpha confidence interval of the tested parameter.
+	// One tailed tests give one sided intervals, with an infinite bound.
+	ConfidenceInterval pd.Interval
+	// EffectSizeName is the symbol of the effect size, e.g. "d", "r" or "V".
+	EffectSizeName string
+	// EffectSize is the value of the effect size.
+	EffectSize float64
+	// Alternative is the direction of the alternative hypothesis.
+	Alternative TailDirection
+	// Accepted is true if the null hypothesis is accepted at the Alpha significance level and false otherwise.
+	Accepted bool
+}
+
+// newTestResult returns a TestResult with the given statistic and p-value and every optional field set to NaN.
+func newTestResult(name string, statisticName string, statistic float64, pvalue float64, alpha float64, tails TailDirection) TestResult {
+	return TestResult{
+		Name:               name,
+		StatisticName:      statisticName,
+		Statistic:          statistic,
+		DF:                 math.NaN(),
+		DF2:                math.NaN(),
+		PValue:             pvalue,
+		Alpha:              alpha,
+		ConfidenceInterval: pd.Interval{Min: math.NaN(), Max: math.NaN()},
+		EffectSize:         math.NaN(),
+		Alternative:        tails,
+		Accepted:           pvalue >= alpha,
+	}
+}
+
+// String returns an APA style report of the test, e.g.
+// "One sample t test: t(12) = 0.42, p = .684, 95% CI [-0.10, 0.15], d = 0.12".
+func (r TestResult) String() string {
+	var b strings.Builder
+	if r.Name != "" {
+		b.WriteString(r.Name)
+		b.WriteString(": ")
+	}
+	if r.StatisticName != "" {
+		b.WriteString(r.StatisticName)
+		if !math.IsNaN(r.DF) {
+			b.WriteString("(")
+			b.WriteString(formatDF(r.DF))
+			if !math.IsNaN(r.DF2) {
+				b.WriteString(", ")
+				b.WriteString(formatDF(r.DF2))
+			}
+			b.WriteString(")")
+		}
+		fmt.Fprintf(&b, " = %s, ", formatAPA(r.Statistic))
+	}
+	b.WriteString(formatPValue(r.PValue))
+	ci := r.ConfidenceInterval
+	if !math.IsNaN(ci.Min) && !math.IsNaN(ci.Max) {
+		fmt.Fprintf(&b, ", %s%% CI [%s, %s]", formatLevel(1-r.Alpha), formatAPA(ci.Min), formatAPA(ci.Max))
+	}
+	if r.EffectSizeName != "" && !math.IsNaN(r.EffectSize) {
+		fmt.Fprintf(&b, ", %s = %s", r.EffectSizeName, formatAPA(r.EffectSize))
+	}
+	return b.String()
+}
+
+// formatAPA formats a number with two decimals.
+func formatAPA(x float64) string {
+	switch {
+	case math.IsInf(x, 1):
+		return "∞"
+	case math.IsInf(x, -1):
+		return "-∞"
+	}
+	return fmt.Sprintf("%.2f", x)
+}
+
+// formatDF formats degrees of freedom, with two decimals only if they are fractional.
+func formatDF(df float64) string {
+	if df == math.Trunc(df) {
+		return fmt.Sprintf("%.0f", df)
+	}
+	return fmt.Sprintf("%.2f", df)
+}
+
+// formatPValue formats a p-value following APA: three decimals without the leading zero and p < .001 for small values.
+func formatPValue(p float64) string {
+	switch {
+	case math.IsNaN(p):
+		return "p = NaN"
+	case p < 0.001:
+		return "p < .001"
+	case p >= 0.9995:
+		return "p > .999"
+	}
+	return "p = " + strings.TrimPrefix(fmt.Sprintf("%.3f", p), "0")
+}
+
+// formatLevel formats a confidence level as a percentage without trailing zeros.
+func formatLevel(level float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", 100*level), "0"), ".")
+}
+
+// criticalValues returns the bounds of the rejection region of dist for the given significance level and tails.
+func criticalValues(dist pd.Distribution, alpha float64, tails TailDirection) []float64 {
+	switch tails {
+	case TailRight:
+		return []float64{dist.Quantile(1 - alpha)}
+	case TailLeft:
+		return []float64{dist.Quantile(alpha)}
+	case TailBoth:
+		return []float64{dist.Quantile(alpha / 2), dist.Quantile(1 - alpha/2)}
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
+
+// locationInterval returns the 1-alpha confidence interval estimate ± q*se, where q is a quantile of the
+// symmetric distribution dist. One tailed alternatives give one sided intervals.
+func locationInterval(estimate float64, se float64, dist pd.Distribution, alpha float64, tails TailDirection) pd.Interval {
+	switch tails {
+	case TailRight:
+		return pd.Interval{Min: estimate - dist.Quantile(1-alpha)*se, Max: math.Inf(1)}
+	case TailLeft:
+		return pd.Interval{Min: math.Inf(-1), Max: estimate + dist.Quantile(1-alpha)*se}
+	case TailBoth:
+		q := dist.Quantile(1 - alpha/2)
+		return pd.Interval{Min: estimate - q*se, Max: estimate + q*se}
+	default:
+		panic("stats: incorrect tails input value. Try TailRight, TailLeft or TailBoth")
+	}
+}
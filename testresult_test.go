@@ -0,0 +1,47 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	pd "github.com/orvend/stats/probdist"
+)
+
+func TestTestResult_String(t *testing.T) {
+	tests := []struct {
+		name string
+		res  TestResult
+		want string
+	}{
+		{"t test case", TestResult{Name: "One sample t test", StatisticName: "t", Statistic: 4.242641, DF: 4, DF2: math.NaN(), PValue: 0.01324,
+			Alpha: 0.05, ConfidenceInterval: pd.Interval{Min: 1.036757, Max: 4.963243}, EffectSizeName: "d", EffectSize: 1.897367},
+			"One sample t test: t(4) = 4.24, p = .013, 95% CI [1.04, 4.96], d = 1.90"},
+		{"F test case", TestResult{Name: "One-way ANOVA", StatisticName: "F", Statistic: 9.264706, DF: 2, DF2: 15, PValue: 0.002398,
+			Alpha: 0.05, ConfidenceInterval: pd.Interval{Min: math.NaN(), Max: math.NaN()}, EffectSizeName: "η²", EffectSize: 0.552632},
+			"One-way ANOVA: F(2, 15) = 9.26, p = .002, η² = 0.55"},
+		{"Fractional df case", TestResult{StatisticName: "t", Statistic: -2.5, DF: 17.3456, DF2: math.NaN(), PValue: 0.0004,
+			Alpha: 0.01, ConfidenceInterval: pd.Interval{Min: math.Inf(-1), Max: -0.5}, EffectSize: math.NaN()},
+			"t(17.35) = -2.50, p < .001, 99% CI [-∞, -0.50]"},
+		{"No df case", TestResult{StatisticName: "z", Statistic: 0.1, DF: math.NaN(), DF2: math.NaN(), PValue: 0.9999,
+			Alpha: 0.1, ConfidenceInterval: pd.Interval{Min: math.NaN(), Max: math.NaN()}, EffectSize: math.NaN()},
+			"z = 0.10, p > .999"},
+		{"No statistic case", TestResult{Name: "Exact test", DF: math.NaN(), DF2: math.NaN(), PValue: 0.5, Alpha: 0.025,
+			ConfidenceInterval: pd.Interval{Min: 0.1, Max: 0.2}, EffectSize: math.NaN()},
+			"Exact test: p = .500, 97.5% CI [0.10, 0.20]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTestResult_StringFromTest(t *testing.T) {
+	res := OneSampleTTest([]float64{1, 2, 3, 4, 5}, 0, 0.05, TailBoth)
+	want := "One sample t test: t(4) = 4.24, p = .013, 95% CI [1.04, 4.96], d = 1.90"
+	if got := res.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}